@@ -0,0 +1,199 @@
+package binarytree
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+)
+
+// AVLTree is a binary search tree in which the heights of the two subtrees of every node
+// differ by at most one. Put and Delete restore that balance with rotations on the way back
+// up, so the height stays below 1.44 log2(n) and all operations take O(log n).
+type AVLTree[K cmp.Ordered, V any] struct {
+	root *node[K, V]
+	size int
+}
+
+// NewAVLTree creates a new, empty AVLTree.
+func NewAVLTree[K cmp.Ordered, V any]() *AVLTree[K, V] {
+	return &AVLTree[K, V]{}
+}
+
+func height[K cmp.Ordered, V any](n *node[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (t *AVLTree[K, V]) update(n *node[K, V]) {
+	n.height = 1 + max(height(n.left), height(n.right))
+}
+
+func (t *AVLTree[K, V]) rotateLeft(n *node[K, V]) *node[K, V] {
+	x := n.right
+	n.right = x.left
+	x.left = n
+	t.update(n)
+	t.update(x)
+	return x
+}
+
+func (t *AVLTree[K, V]) rotateRight(n *node[K, V]) *node[K, V] {
+	x := n.left
+	n.left = x.right
+	x.right = n
+	t.update(n)
+	t.update(x)
+	return x
+}
+
+// balance updates the height of n and rotates its subtree back into balance if its
+// children differ in height by two.
+func (t *AVLTree[K, V]) balance(n *node[K, V]) *node[K, V] {
+	t.update(n)
+	switch factor := height(n.left) - height(n.right); {
+	case factor > 1:
+		if height(n.left.left) < height(n.left.right) {
+			n.left = t.rotateLeft(n.left)
+		}
+		return t.rotateRight(n)
+	case factor < -1:
+		if height(n.right.right) < height(n.right.left) {
+			n.right = t.rotateRight(n.right)
+		}
+		return t.rotateLeft(n)
+	}
+	return n
+}
+
+// Put sets the value for the key. It returns the previous value and whether the key was present.
+func (t *AVLTree[K, V]) Put(key K, value V) (V, bool) {
+	var previous V
+	var replaced bool
+	t.root = t.put(t.root, key, value, &previous, &replaced)
+	if !replaced {
+		t.size++
+	}
+	return previous, replaced
+}
+
+func (t *AVLTree[K, V]) put(n *node[K, V], key K, value V, previous *V, replaced *bool) *node[K, V] {
+	if n == nil {
+		return &node[K, V]{key: key, value: value, height: 1}
+	}
+	switch c := cmp.Compare(key, n.key); {
+	case c < 0:
+		n.left = t.put(n.left, key, value, previous, replaced)
+	case c > 0:
+		n.right = t.put(n.right, key, value, previous, replaced)
+	default:
+		*previous, *replaced = n.value, true
+		n.value = value
+		return n
+	}
+	return t.balance(n)
+}
+
+// Get returns the value stored for the key and whether it was found.
+func (t *AVLTree[K, V]) Get(key K) (V, bool) {
+	return nodeValue(find(t.root, key))
+}
+
+// Delete deletes the key and reports whether it was present.
+func (t *AVLTree[K, V]) Delete(key K) bool {
+	if find(t.root, key) == nil {
+		return false
+	}
+	t.root = t.delete(t.root, key)
+	t.size--
+	return true
+}
+
+// delete removes the key, which must be present, from the subtree of n.
+func (t *AVLTree[K, V]) delete(n *node[K, V], key K) *node[K, V] {
+	switch c := cmp.Compare(key, n.key); {
+	case c < 0:
+		n.left = t.delete(n.left, key)
+	case c > 0:
+		n.right = t.delete(n.right, key)
+	default:
+		if n.left == nil {
+			return n.right
+		}
+		if n.right == nil {
+			return n.left
+		}
+		// replace the node by its successor
+		successor := minNode(n.right)
+		n.key, n.value = successor.key, successor.value
+		n.right = t.deleteMin(n.right)
+	}
+	return t.balance(n)
+}
+
+func (t *AVLTree[K, V]) deleteMin(n *node[K, V]) *node[K, V] {
+	if n.left == nil {
+		return n.right
+	}
+	n.left = t.deleteMin(n.left)
+	return t.balance(n)
+}
+
+// Len returns the number of keys in the tree.
+func (t *AVLTree[K, V]) Len() int {
+	return t.size
+}
+
+// Height returns the number of nodes on the longest path from the root to a leaf.
+func (t *AVLTree[K, V]) Height() int {
+	return height(t.root)
+}
+
+// MinKey returns the smallest key of the tree.
+func (t *AVLTree[K, V]) MinKey() (K, bool) {
+	return nodeKey(minNode(t.root))
+}
+
+// MaxKey returns the greatest key of the tree.
+func (t *AVLTree[K, V]) MaxKey() (K, bool) {
+	return nodeKey(maxNode(t.root))
+}
+
+// All returns an iterator over all keys of the tree in ascending order.
+// The tree must not be modified while iterating.
+func (t *AVLTree[K, V]) All() iter.Seq2[K, V] {
+	return inOrder(t.root)
+}
+
+// Validate checks that the keys are in search tree order, that every node records the height
+// of its subtree and that no node is out of balance.
+func (t *AVLTree[K, V]) Validate() error {
+	if err := validateOrder(t.root, t.size); err != nil {
+		return err
+	}
+	_, err := t.validateBalance(t.root)
+	return err
+}
+
+// validateBalance returns the actual height of the subtree of n.
+func (t *AVLTree[K, V]) validateBalance(n *node[K, V]) (int, error) {
+	if n == nil {
+		return 0, nil
+	}
+	left, err := t.validateBalance(n.left)
+	if err != nil {
+		return 0, err
+	}
+	right, err := t.validateBalance(n.right)
+	if err != nil {
+		return 0, err
+	}
+	if h := 1 + max(left, right); n.height != h {
+		return 0, fmt.Errorf("binarytree: node %v records height %d, has %d", n.key, n.height, h)
+	}
+	if left-right > 1 || right-left > 1 {
+		return 0, fmt.Errorf("binarytree: node %v out of balance, subtree heights %d and %d", n.key, left, right)
+	}
+	return n.height, nil
+}
@@ -2,6 +2,7 @@ package binarytree
 
 import (
 	"fmt"
+	"iter"
 	"math"
 )
 
@@ -24,11 +25,68 @@ func (t *Tree) Search(key int) bool {
 	return t.Root.Search(key)
 }
 
-func (t *Tree) Delete(key int) {
-	if t.Root == nil {
-		return
+// Delete deletes the key and reports whether it was present.
+func (t *Tree) Delete(key int) bool {
+	if !t.Search(key) {
+		return false
+	}
+	t.Root = t.Root.Delete(key)
+	return true
+}
+
+// Put inserts the key, the tree holds keys only. It reports whether the key was present.
+func (t *Tree) Put(key int, value struct{}) (struct{}, bool) {
+	found := t.Search(key)
+	if !found {
+		t.Insert(key)
+	}
+	return value, found
+}
+
+// Get reports whether the key is present.
+func (t *Tree) Get(key int) (struct{}, bool) {
+	return struct{}{}, t.Search(key)
+}
+
+// Len returns the number of keys in the tree, counting them in O(n).
+func (t *Tree) Len() int {
+	count := 0
+	for range t.All() {
+		count++
+	}
+	return count
+}
+
+// All returns an iterator over all keys of the tree in ascending order.
+// The tree must not be modified while iterating.
+func (t *Tree) All() iter.Seq2[int, struct{}] {
+	return func(yield func(int, struct{}) bool) {
+		var stack []*TreeNode
+		for n := t.Root; n != nil || len(stack) > 0; {
+			for ; n != nil; n = n.LeftChild {
+				stack = append(stack, n)
+			}
+			n = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(n.Data, struct{}{}) {
+				return
+			}
+			n = n.RightChild
+		}
+	}
+}
+
+// Validate checks that the keys are in strictly ascending order from left to right.
+func (t *Tree) Validate() error {
+	first := true
+	var previous int
+	for key := range t.All() {
+		if !first && key <= previous {
+			return fmt.Errorf("binarytree: key %d follows key %d in order", key, previous)
+		}
+		first, previous = false, key
 	}
-	t.Root.Delete(key)
+	return nil
 }
 
 func (t *Tree) InOrderTransversal() {
@@ -73,6 +131,24 @@ func (t *Tree) Max() int {
 	return t.Root.Max()
 }
 
+// MinKey returns the smallest key of the tree and false if the tree is empty,
+// where Min returns math.MinInt.
+func (t *Tree) MinKey() (int, bool) {
+	if t.Root == nil {
+		return 0, false
+	}
+	return t.Root.Min(), true
+}
+
+// MaxKey returns the greatest key of the tree and false if the tree is empty,
+// where Max returns math.MaxInt.
+func (t *Tree) MaxKey() (int, bool) {
+	if t.Root == nil {
+		return 0, false
+	}
+	return t.Root.Max(), true
+}
+
 func BST() {
 	t := &Tree{}
 	t.Insert(35)
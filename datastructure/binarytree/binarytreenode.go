@@ -84,7 +84,9 @@ func (t *TreeNode) Delete(key int) *TreeNode {
 }
 
 func (t *TreeNode) Search(key int) bool {
-
+	if t == nil {
+		return false
+	}
 	if t.Data == key {
 		return true
 	} else if key < t.Data {
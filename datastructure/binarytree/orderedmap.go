@@ -0,0 +1,145 @@
+package binarytree
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+)
+
+// OrderedMap is the common set of operations of the search trees in this package. Tree, the
+// unbalanced BST, is an OrderedMap[int, struct{}] holding a set of keys, while AVLTree and
+// RedBlackTree keep their height logarithmic whatever the insertion order.
+type OrderedMap[K cmp.Ordered, V any] interface {
+	// Put inserts or replaces the value for the key, returning the previous value if any.
+	Put(key K, value V) (V, bool)
+	// Get returns the value stored for the key and whether it was found.
+	Get(key K) (V, bool)
+	// Delete deletes the key and reports whether it was present.
+	Delete(key K) bool
+	// Len returns the number of keys.
+	Len() int
+	// MinKey returns the smallest key.
+	MinKey() (K, bool)
+	// MaxKey returns the greatest key.
+	MaxKey() (K, bool)
+	// All returns an iterator over the key-value pairs in ascending key order.
+	All() iter.Seq2[K, V]
+	// Validate checks the invariants of the tree and reports the first violation found.
+	Validate() error
+}
+
+var (
+	_ OrderedMap[int, struct{}] = (*Tree)(nil)
+	_ OrderedMap[int, string]   = (*AVLTree[int, string])(nil)
+	_ OrderedMap[int, string]   = (*RedBlackTree[int, string])(nil)
+)
+
+// node is the node of the balanced trees. Each tree only uses its own balancing field.
+type node[K cmp.Ordered, V any] struct {
+	key    K
+	value  V
+	left   *node[K, V]
+	right  *node[K, V]
+	height int  // AVL: height of the subtree, 1 for a leaf
+	red    bool // red-black: color of the link from the parent
+}
+
+func find[K cmp.Ordered, V any](n *node[K, V], key K) *node[K, V] {
+	for n != nil {
+		switch c := cmp.Compare(key, n.key); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+func minNode[K cmp.Ordered, V any](n *node[K, V]) *node[K, V] {
+	for n != nil && n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func maxNode[K cmp.Ordered, V any](n *node[K, V]) *node[K, V] {
+	for n != nil && n.right != nil {
+		n = n.right
+	}
+	return n
+}
+
+func nodeValue[K cmp.Ordered, V any](n *node[K, V]) (V, bool) {
+	if n == nil {
+		var zeroValue V
+		return zeroValue, false
+	}
+	return n.value, true
+}
+
+func nodeKey[K cmp.Ordered, V any](n *node[K, V]) (K, bool) {
+	if n == nil {
+		var zeroKey K
+		return zeroKey, false
+	}
+	return n.key, true
+}
+
+// inOrder returns an iterator over the subtree of n in key order. It keeps its own stack
+// instead of recursing.
+func inOrder[K cmp.Ordered, V any](n *node[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var stack []*node[K, V]
+		for n != nil || len(stack) > 0 {
+			for ; n != nil; n = n.left {
+				stack = append(stack, n)
+			}
+			n = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(n.key, n.value) {
+				return
+			}
+			n = n.right
+		}
+	}
+}
+
+// validateOrder checks that the keys of the subtree of n are strictly ascending and that
+// there are size of them.
+func validateOrder[K cmp.Ordered, V any](n *node[K, V], size int) error {
+	count := 0
+	var previous K
+	for key := range inOrder(n) {
+		if count > 0 && cmp.Compare(key, previous) <= 0 {
+			return fmt.Errorf("binarytree: key %v follows key %v in order", key, previous)
+		}
+		previous = key
+		count++
+	}
+	if count != size {
+		return fmt.Errorf("binarytree: %d keys reachable, size is %d", count, size)
+	}
+	return nil
+}
+
+func RunBalancedTrees() {
+	avl := NewAVLTree[int, string]()
+	rb := NewRedBlackTree[int, string]()
+	// sorted IDs would turn the unbalanced Tree into a linked list
+	for id := 1; id <= 1000; id++ {
+		avl.Put(id, fmt.Sprintf("user-%d", id))
+		rb.Put(id, fmt.Sprintf("user-%d", id))
+	}
+	fmt.Println("AVL height:", avl.Height(), "red-black height:", rb.Height())
+
+	for _, m := range []OrderedMap[int, string]{avl, rb} {
+		m.Delete(500)
+		v, ok := m.Get(501)
+		minKey, _ := m.MinKey()
+		maxKey, _ := m.MaxKey()
+		fmt.Println(m.Len(), v, ok, minKey, maxKey, m.Validate())
+	}
+}
@@ -0,0 +1,139 @@
+package binarytree
+
+import (
+	"maps"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+const (
+	operations = 5000
+	keyRange   = 300
+)
+
+// testOrderedMap applies seeded random Puts and Deletes to the empty map m and to a Go map,
+// validating m after every operation. value derives the value stored for a key and step.
+func testOrderedMap[V comparable](t *testing.T, m OrderedMap[int, V], value func(key, step int) V) {
+	t.Helper()
+	r := rand.New(rand.NewPCG(1, 2))
+	oracle := make(map[int]V)
+
+	for step := range operations {
+		key := r.IntN(keyRange)
+		want, present := oracle[key]
+		// favour Put so the tree grows before it shrinks
+		if r.IntN(3) > 0 {
+			v := value(key, step)
+			previous, replaced := m.Put(key, v)
+			if replaced != present || previous != want {
+				t.Fatalf("step %d: Put(%d) = %v, %t; want %v, %t", step, key, previous, replaced, want, present)
+			}
+			oracle[key] = v
+		} else {
+			if deleted := m.Delete(key); deleted != present {
+				t.Fatalf("step %d: Delete(%d) = %t, want %t", step, key, deleted, present)
+			}
+			delete(oracle, key)
+		}
+		if err := m.Validate(); err != nil {
+			t.Fatalf("step %d: %v", step, err)
+		}
+		if m.Len() != len(oracle) {
+			t.Fatalf("step %d: Len() = %d, want %d", step, m.Len(), len(oracle))
+		}
+		want, present = oracle[key]
+		if got, ok := m.Get(key); got != want || ok != present {
+			t.Fatalf("step %d: Get(%d) = %v, %t; want %v, %t", step, key, got, ok, want, present)
+		}
+		if step%100 == 0 {
+			checkContents(t, m, oracle)
+		}
+	}
+	checkContents(t, m, oracle)
+
+	for _, key := range slices.Collect(maps.Keys(oracle)) {
+		if !m.Delete(key) {
+			t.Fatalf("Delete(%d) = false for a present key", key)
+		}
+		if err := m.Validate(); err != nil {
+			t.Fatalf("emptying the tree: %v", err)
+		}
+	}
+	if m.Len() != 0 {
+		t.Fatalf("Len() = %d after deleting every key", m.Len())
+	}
+	if _, ok := m.MinKey(); ok {
+		t.Fatal("MinKey() of an empty tree reported ok")
+	}
+}
+
+// checkContents compares All, MinKey and MaxKey with the oracle.
+func checkContents[V comparable](t *testing.T, m OrderedMap[int, V], oracle map[int]V) {
+	t.Helper()
+	keys := slices.Sorted(maps.Keys(oracle))
+	var got []int
+	for key, v := range m.All() {
+		if v != oracle[key] {
+			t.Fatalf("All() yielded %d: %v, want %v", key, v, oracle[key])
+		}
+		got = append(got, key)
+	}
+	if !slices.Equal(got, keys) {
+		t.Fatalf("All() yielded keys %v, want %v", got, keys)
+	}
+	minKey, minOK := m.MinKey()
+	maxKey, maxOK := m.MaxKey()
+	if len(keys) == 0 {
+		if minOK || maxOK {
+			t.Fatal("MinKey() or MaxKey() of an empty tree reported ok")
+		}
+		return
+	}
+	if !minOK || minKey != keys[0] || !maxOK || maxKey != keys[len(keys)-1] {
+		t.Fatalf("MinKey(), MaxKey() = %d, %d; want %d, %d", minKey, maxKey, keys[0], keys[len(keys)-1])
+	}
+}
+
+func TestTreeRandom(t *testing.T) {
+	testOrderedMap(t, &Tree{}, func(int, int) struct{} { return struct{}{} })
+}
+
+func TestAVLTreeRandom(t *testing.T) {
+	testOrderedMap(t, NewAVLTree[int, int](), func(key, step int) int { return key*operations + step })
+}
+
+func TestRedBlackTreeRandom(t *testing.T) {
+	testOrderedMap(t, NewRedBlackTree[int, int](), func(key, step int) int { return key*operations + step })
+}
+
+func TestBalancedTreeHeight(t *testing.T) {
+	const n = 1 << 12
+	avl := NewAVLTree[int, int]()
+	rb := NewRedBlackTree[int, int]()
+	// ascending keys, the worst case of an unbalanced tree
+	for i := range n {
+		avl.Put(i, i)
+		rb.Put(i, i)
+	}
+	if h, limit := avl.Height(), 1.44*math.Log2(n+2); float64(h) > limit {
+		t.Errorf("AVL height %d above %.1f", h, limit)
+	}
+	if h, limit := rb.Height(), 2*math.Log2(n+1); float64(h) > limit {
+		t.Errorf("red-black height %d above %.1f", h, limit)
+	}
+}
+
+func TestTreeMinMax(t *testing.T) {
+	tree := &Tree{}
+	if tree.Min() != math.MinInt || tree.Max() != math.MaxInt {
+		t.Errorf("Min(), Max() of an empty tree = %d, %d; want math.MinInt, math.MaxInt", tree.Min(), tree.Max())
+	}
+	for _, key := range []int{5, 3, 8, 1} {
+		tree.Insert(key)
+	}
+	if tree.Min() != 1 || tree.Max() != 8 {
+		t.Errorf("Min(), Max() = %d, %d; want 1, 8", tree.Min(), tree.Max())
+	}
+}
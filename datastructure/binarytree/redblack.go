@@ -0,0 +1,253 @@
+package binarytree
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"iter"
+)
+
+// RedBlackTree is a left-leaning red-black tree, Sedgewick's encoding of a 2-3 tree as a
+// binary search tree: a red link glues a node to its parent into a 3-node, and red links
+// always lean left. Every path from the root to a leaf crosses the same number of black
+// links, so the height stays below 2 log2(n) and all operations take O(log n).
+type RedBlackTree[K cmp.Ordered, V any] struct {
+	root *node[K, V]
+	size int
+}
+
+// NewRedBlackTree creates a new, empty RedBlackTree.
+func NewRedBlackTree[K cmp.Ordered, V any]() *RedBlackTree[K, V] {
+	return &RedBlackTree[K, V]{}
+}
+
+func isRed[K cmp.Ordered, V any](n *node[K, V]) bool {
+	return n != nil && n.red
+}
+
+func (t *RedBlackTree[K, V]) rotateLeft(n *node[K, V]) *node[K, V] {
+	x := n.right
+	n.right = x.left
+	x.left = n
+	x.red = n.red
+	n.red = true
+	return x
+}
+
+func (t *RedBlackTree[K, V]) rotateRight(n *node[K, V]) *node[K, V] {
+	x := n.left
+	n.left = x.right
+	x.right = n
+	x.red = n.red
+	n.red = true
+	return x
+}
+
+// flipColors splits or, when deleting, merges the 4-node formed by n and its children.
+func (t *RedBlackTree[K, V]) flipColors(n *node[K, V]) {
+	n.red = !n.red
+	n.left.red = !n.left.red
+	n.right.red = !n.right.red
+}
+
+// fixUp restores the left-leaning invariants on the way back up from an update.
+func (t *RedBlackTree[K, V]) fixUp(n *node[K, V]) *node[K, V] {
+	if isRed(n.right) && !isRed(n.left) {
+		n = t.rotateLeft(n)
+	}
+	if isRed(n.left) && isRed(n.left.left) {
+		n = t.rotateRight(n)
+	}
+	if isRed(n.left) && isRed(n.right) {
+		t.flipColors(n)
+	}
+	return n
+}
+
+// Put sets the value for the key. It returns the previous value and whether the key was present.
+func (t *RedBlackTree[K, V]) Put(key K, value V) (V, bool) {
+	var previous V
+	var replaced bool
+	t.root = t.put(t.root, key, value, &previous, &replaced)
+	t.root.red = false
+	if !replaced {
+		t.size++
+	}
+	return previous, replaced
+}
+
+func (t *RedBlackTree[K, V]) put(n *node[K, V], key K, value V, previous *V, replaced *bool) *node[K, V] {
+	if n == nil {
+		return &node[K, V]{key: key, value: value, red: true}
+	}
+	switch c := cmp.Compare(key, n.key); {
+	case c < 0:
+		n.left = t.put(n.left, key, value, previous, replaced)
+	case c > 0:
+		n.right = t.put(n.right, key, value, previous, replaced)
+	default:
+		*previous, *replaced = n.value, true
+		n.value = value
+		return n
+	}
+	return t.fixUp(n)
+}
+
+// Get returns the value stored for the key and whether it was found.
+func (t *RedBlackTree[K, V]) Get(key K) (V, bool) {
+	return nodeValue(find(t.root, key))
+}
+
+// Delete deletes the key and reports whether it was present.
+func (t *RedBlackTree[K, V]) Delete(key K) bool {
+	if find(t.root, key) == nil {
+		return false
+	}
+	if !isRed(t.root.left) && !isRed(t.root.right) {
+		t.root.red = true
+	}
+	t.root = t.delete(t.root, key)
+	if t.root != nil {
+		t.root.red = false
+	}
+	t.size--
+	return true
+}
+
+// moveRedLeft makes n.left or one of its children red, assuming n is red and both
+// n.left and n.left.left are black.
+func (t *RedBlackTree[K, V]) moveRedLeft(n *node[K, V]) *node[K, V] {
+	t.flipColors(n)
+	if isRed(n.right.left) {
+		n.right = t.rotateRight(n.right)
+		n = t.rotateLeft(n)
+		t.flipColors(n)
+	}
+	return n
+}
+
+// moveRedRight makes n.right or one of its children red, assuming n is red and both
+// n.right and n.right.left are black.
+func (t *RedBlackTree[K, V]) moveRedRight(n *node[K, V]) *node[K, V] {
+	t.flipColors(n)
+	if isRed(n.left.left) {
+		n = t.rotateRight(n)
+		t.flipColors(n)
+	}
+	return n
+}
+
+// delete removes the key, which must be present, from the subtree of n. On the way down it
+// keeps the current node out of a 2-node, so the key can be removed from the bottom.
+func (t *RedBlackTree[K, V]) delete(n *node[K, V], key K) *node[K, V] {
+	if cmp.Less(key, n.key) {
+		if !isRed(n.left) && !isRed(n.left.left) {
+			n = t.moveRedLeft(n)
+		}
+		n.left = t.delete(n.left, key)
+		return t.fixUp(n)
+	}
+	if isRed(n.left) {
+		n = t.rotateRight(n)
+	}
+	if cmp.Compare(key, n.key) == 0 && n.right == nil {
+		return nil
+	}
+	if !isRed(n.right) && !isRed(n.right.left) {
+		n = t.moveRedRight(n)
+	}
+	if cmp.Compare(key, n.key) == 0 {
+		// replace the node by its successor
+		successor := minNode(n.right)
+		n.key, n.value = successor.key, successor.value
+		n.right = t.deleteMin(n.right)
+	} else {
+		n.right = t.delete(n.right, key)
+	}
+	return t.fixUp(n)
+}
+
+func (t *RedBlackTree[K, V]) deleteMin(n *node[K, V]) *node[K, V] {
+	if n.left == nil {
+		return nil
+	}
+	if !isRed(n.left) && !isRed(n.left.left) {
+		n = t.moveRedLeft(n)
+	}
+	n.left = t.deleteMin(n.left)
+	return t.fixUp(n)
+}
+
+// Len returns the number of keys in the tree.
+func (t *RedBlackTree[K, V]) Len() int {
+	return t.size
+}
+
+// Height returns the number of nodes on the longest path from the root to a leaf.
+func (t *RedBlackTree[K, V]) Height() int {
+	var depth func(n *node[K, V]) int
+	depth = func(n *node[K, V]) int {
+		if n == nil {
+			return 0
+		}
+		return 1 + max(depth(n.left), depth(n.right))
+	}
+	return depth(t.root)
+}
+
+// MinKey returns the smallest key of the tree.
+func (t *RedBlackTree[K, V]) MinKey() (K, bool) {
+	return nodeKey(minNode(t.root))
+}
+
+// MaxKey returns the greatest key of the tree.
+func (t *RedBlackTree[K, V]) MaxKey() (K, bool) {
+	return nodeKey(maxNode(t.root))
+}
+
+// All returns an iterator over all keys of the tree in ascending order.
+// The tree must not be modified while iterating.
+func (t *RedBlackTree[K, V]) All() iter.Seq2[K, V] {
+	return inOrder(t.root)
+}
+
+// Validate checks that the keys are in search tree order, that the root is black, that red
+// links lean left and never follow each other and that all paths have the same black height.
+func (t *RedBlackTree[K, V]) Validate() error {
+	if err := validateOrder(t.root, t.size); err != nil {
+		return err
+	}
+	if isRed(t.root) {
+		return errors.New("binarytree: red root")
+	}
+	_, err := t.validateColors(t.root)
+	return err
+}
+
+// validateColors returns the number of black links on every path down from n.
+func (t *RedBlackTree[K, V]) validateColors(n *node[K, V]) (int, error) {
+	if n == nil {
+		return 0, nil
+	}
+	if isRed(n.right) {
+		return 0, fmt.Errorf("binarytree: right leaning red link below %v", n.key)
+	}
+	if isRed(n) && isRed(n.left) {
+		return 0, fmt.Errorf("binarytree: two red links in a row at %v", n.key)
+	}
+	left, err := t.validateColors(n.left)
+	if err != nil {
+		return 0, err
+	}
+	right, err := t.validateColors(n.right)
+	if err != nil {
+		return 0, err
+	}
+	if left != right {
+		return 0, fmt.Errorf("binarytree: black heights %d and %d below %v", left, right, n.key)
+	}
+	if !n.red {
+		left++
+	}
+	return left, nil
+}
@@ -0,0 +1,127 @@
+/*
+	Package cache implements bounded in-memory caches with LRU and LFU eviction.
+
+	Both caches use the generic HashTable of the generics package for key lookup and a linkedlist.Deque
+	of entries to keep track of the eviction order.
+	Entries can optionally expire after a time-to-live, the clock used for expiry is injectable so
+	expiry can be tested without sleeping. Expired entries are removed lazily when they are looked up,
+	or all at once with RemoveExpired.
+
+	The caches are not safe for concurrent use.
+*/
+
+package cache
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dev-crusader/data-structures-and-algorithms/datastructure/linkedlist"
+)
+
+// Clock returns the current time, time.Now is used when none is given.
+type Clock func() time.Time
+
+// Options configures a cache. The zero value is a cache without expiry or eviction callback.
+type Options[K comparable, V any] struct {
+	// OnEvict is called with every entry removed because the cache was full or the entry expired.
+	OnEvict func(key K, value V)
+	// TTL is the time-to-live of entries added with Put, zero means entries never expire.
+	TTL time.Duration
+	// Clock is the time source used for expiry.
+	Clock Clock
+}
+
+// Stats holds the hit and miss statistics of a cache.
+type Stats struct {
+	Hits        uint64
+	Misses      uint64
+	Evictions   uint64 // entries evicted to make room for new ones
+	Expirations uint64 // entries removed because their TTL elapsed
+}
+
+// HitRate returns the fraction of lookups that were hits.
+func (s Stats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
+
+// Cache is the common set of operations of the LRU and LFU caches.
+type Cache[K comparable, V any] interface {
+	Get(key K) (V, bool)
+	Put(key K, value V)
+	PutWithTTL(key K, value V, ttl time.Duration)
+	Delete(key K) bool
+	Len() int
+	Cap() int
+	Stats() Stats
+}
+
+var (
+	_ Cache[string, int] = (*LRU[string, int])(nil)
+	_ Cache[string, int] = (*LFU[string, int])(nil)
+)
+
+// entry is a cached key-value pair.
+type entry[K comparable, V any] struct {
+	element   *linkedlist.Element[*entry[K, V]] // the node of the entry in its eviction order list
+	key       K
+	value     V
+	expiresAt time.Time // zero if the entry never expires
+	frequency int       // number of accesses, only used by the LFU cache
+}
+
+func (e *entry[K, V]) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
+
+// expiry computes the expiry time of an entry added now with the given TTL.
+func expiry(clock Clock, ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return clock().Add(ttl)
+}
+
+func clockOrDefault(clock Clock) Clock {
+	if clock == nil {
+		return time.Now
+	}
+	return clock
+}
+
+// RunCache function to demonstrate the LRU and LFU caches.
+func RunCache() {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	lru := NewLRU(3, Options[string, int]{
+		OnEvict: func(key string, value int) { fmt.Printf("LRU evicted %s: %d\n", key, value) },
+		Clock:   clock,
+	})
+	lru.Put("a", 1)
+	lru.Put("b", 2)
+	lru.Put("c", 3)
+	lru.Get("a")
+	lru.Put("d", 4) // evicts b, the least recently used
+	fmt.Println(lru.Get("b"))
+	lru.PutWithTTL("e", 5, time.Minute) // evicts c
+	now = now.Add(2 * time.Minute)
+	fmt.Println(lru.Get("e"))
+	fmt.Printf("LRU stats: %+v, hit rate %.2f\n", lru.Stats(), lru.Stats().HitRate())
+
+	lfu := NewLFU(2, Options[string, int]{
+		OnEvict: func(key string, value int) { fmt.Printf("LFU evicted %s: %d\n", key, value) },
+	})
+	lfu.Put("x", 10)
+	lfu.Put("y", 20)
+	lfu.Get("x")
+	lfu.Get("x")
+	lfu.Get("y")
+	lfu.Put("z", 30) // evicts y, the least frequently used
+	fmt.Println(lfu.Get("x"))
+	fmt.Printf("LFU stats: %+v\n", lfu.Stats())
+}
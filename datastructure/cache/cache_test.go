@@ -0,0 +1,277 @@
+package cache
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+	"time"
+)
+
+// fakeClock is a Clock which only moves when advanced.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+// recorder collects the keys passed to OnEvict.
+type recorder struct {
+	keys []string
+}
+
+func (r *recorder) options(clock *fakeClock, ttl time.Duration) Options[string, int] {
+	return Options[string, int]{
+		OnEvict: func(key string, _ int) { r.keys = append(r.keys, key) },
+		TTL:     ttl,
+		Clock:   clock.Now,
+	}
+}
+
+func TestLRUEvictionOrder(t *testing.T) {
+	var evicted recorder
+	c := NewLRU(3, evicted.options(newFakeClock(), 0))
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("a")     // b is now the least recently used
+	c.Put("d", 4)  // evicts b
+	c.Put("c", 33) // updating c makes it recently used, a is the oldest
+	c.Put("e", 5)  // evicts a
+	c.Put("f", 6)  // evicts d
+
+	if want := []string{"b", "a", "d"}; !slices.Equal(evicted.keys, want) {
+		t.Errorf("evicted %v, want %v", evicted.keys, want)
+	}
+	for key, want := range map[string]int{"c": 33, "e": 5, "f": 6} {
+		if v, ok := c.Get(key); !ok || v != want {
+			t.Errorf("Get(%q) = %d, %t; want %d, true", key, v, ok, want)
+		}
+	}
+	if c.Len() != 3 || c.Stats().Evictions != 3 {
+		t.Errorf("Len() = %d, %d evictions; want 3, 3", c.Len(), c.Stats().Evictions)
+	}
+}
+
+func TestLFUEvictionOrder(t *testing.T) {
+	var evicted recorder
+	c := NewLFU(3, evicted.options(newFakeClock(), 0))
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("a")
+	c.Get("a")
+	c.Get("b")
+	c.Get("c")    // a: 3, b: 2, c: 2, b is the least recently used of the two
+	c.Put("d", 4) // evicts b
+	c.Put("e", 5) // d has frequency 1, evicts it
+	c.Get("e")
+	c.Get("e")    // a: 3, c: 2, e: 3
+	c.Put("f", 6) // evicts c
+
+	if want := []string{"b", "d", "c"}; !slices.Equal(evicted.keys, want) {
+		t.Errorf("evicted %v, want %v", evicted.keys, want)
+	}
+	if c.minFrequency != 1 {
+		t.Errorf("minFrequency = %d after adding f, want 1", c.minFrequency)
+	}
+}
+
+func TestLFUDeleteLowestFrequency(t *testing.T) {
+	var evicted recorder
+	c := NewLFU(3, evicted.options(newFakeClock(), 0))
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	c.Get("b")
+	c.Get("c")
+	c.Get("c")
+	// deleting the only entry of frequency 1 must find frequency 2 as the lowest
+	c.Delete("a")
+	if c.minFrequency != 2 {
+		t.Fatalf("minFrequency = %d after deleting a, want 2", c.minFrequency)
+	}
+	c.Put("d", 4)
+	c.Get("d")
+	c.Get("d")
+	c.Get("d")    // b: 2, c: 3, d: 4
+	c.Put("e", 5) // evicts b
+	if want := []string{"b"}; !slices.Equal(evicted.keys, want) {
+		t.Errorf("evicted %v, want %v", evicted.keys, want)
+	}
+}
+
+// lfuOracle is a naive LFU cache scanning all entries to pick the one to evict.
+type lfuOracle struct {
+	capacity int
+	clock    int
+	entries  map[int]*struct{ value, frequency, used int }
+}
+
+func (o *lfuOracle) get(key int) (int, bool) {
+	e, ok := o.entries[key]
+	if !ok {
+		return 0, false
+	}
+	o.clock++
+	e.frequency++
+	e.used = o.clock
+	return e.value, true
+}
+
+// put returns the evicted key, or -1.
+func (o *lfuOracle) put(key, value int) int {
+	o.clock++
+	if e, ok := o.entries[key]; ok {
+		e.value, e.frequency, e.used = value, e.frequency+1, o.clock
+		return -1
+	}
+	victim := -1
+	if len(o.entries) >= o.capacity {
+		for k, e := range o.entries {
+			v := o.entries[victim]
+			if victim < 0 || e.frequency < v.frequency || e.frequency == v.frequency && e.used < v.used {
+				victim = k
+			}
+		}
+		delete(o.entries, victim)
+	}
+	o.entries[key] = &struct{ value, frequency, used int }{value, 1, o.clock}
+	return victim
+}
+
+func TestLFURandom(t *testing.T) {
+	const capacity = 8
+	r := rand.New(rand.NewPCG(9, 9))
+	var evicted []int
+	c := NewLFU(capacity, Options[int, int]{OnEvict: func(key, _ int) { evicted = append(evicted, key) }})
+	o := &lfuOracle{capacity: capacity, entries: make(map[int]*struct{ value, frequency, used int })}
+
+	for step := range 20000 {
+		key := r.IntN(3 * capacity)
+		switch r.IntN(5) {
+		case 0, 1:
+			got, ok := c.Get(key)
+			want, wantOK := o.get(key)
+			if got != want || ok != wantOK {
+				t.Fatalf("step %d: Get(%d) = %d, %t; want %d, %t", step, key, got, ok, want, wantOK)
+			}
+		case 2, 3:
+			evicted = evicted[:0]
+			c.Put(key, step)
+			if victim := o.put(key, step); victim >= 0 && !slices.Equal(evicted, []int{victim}) {
+				t.Fatalf("step %d: Put(%d) evicted %v, want %d", step, key, evicted, victim)
+			}
+		case 4:
+			_, present := o.entries[key]
+			if deleted := c.Delete(key); deleted != present {
+				t.Fatalf("step %d: Delete(%d) = %t, want %t", step, key, deleted, present)
+			}
+			delete(o.entries, key)
+		}
+		if c.Len() != len(o.entries) {
+			t.Fatalf("step %d: Len() = %d, want %d", step, c.Len(), len(o.entries))
+		}
+	}
+}
+
+func TestTTL(t *testing.T) {
+	caches := map[string]func(*fakeClock, *recorder) Cache[string, int]{
+		"LRU": func(clock *fakeClock, r *recorder) Cache[string, int] {
+			return NewLRU(4, r.options(clock, time.Minute))
+		},
+		"LFU": func(clock *fakeClock, r *recorder) Cache[string, int] {
+			return NewLFU(4, r.options(clock, time.Minute))
+		},
+	}
+	for name, newCache := range caches {
+		t.Run(name, func(t *testing.T) {
+			clock := newFakeClock()
+			var expired recorder
+			c := newCache(clock, &expired)
+			c.Put("default", 1) // expires after a minute
+			c.PutWithTTL("short", 2, time.Second)
+			c.PutWithTTL("forever", 3, 0)
+
+			clock.Advance(time.Second)
+			if _, ok := c.Get("short"); ok {
+				t.Error("short still cached once its TTL elapsed")
+			}
+			if _, ok := c.Get("default"); !ok {
+				t.Error("default expired before its TTL")
+			}
+
+			// updating an entry restarts its TTL
+			clock.Advance(30 * time.Second)
+			c.Put("default", 11)
+			clock.Advance(45 * time.Second)
+			if v, ok := c.Get("default"); !ok || v != 11 {
+				t.Errorf("Get(default) = %d, %t after the update; want 11, true", v, ok)
+			}
+
+			c.PutWithTTL("a", 4, time.Second)
+			c.PutWithTTL("b", 5, time.Second)
+			clock.Advance(time.Hour)
+			remover := c.(interface{ RemoveExpired() int })
+			if n := remover.RemoveExpired(); n != 3 {
+				t.Errorf("RemoveExpired() = %d, want 3", n)
+			}
+			if v, ok := c.Get("forever"); !ok || v != 3 {
+				t.Errorf("Get(forever) = %d, %t; want 3, true", v, ok)
+			}
+			if c.Len() != 1 {
+				t.Errorf("Len() = %d, want 1", c.Len())
+			}
+
+			slices.Sort(expired.keys)
+			if want := []string{"a", "b", "default", "short"}; !slices.Equal(expired.keys, want) {
+				t.Errorf("OnEvict called for %v, want %v", expired.keys, want)
+			}
+			if s := c.Stats(); s.Expirations != 4 || s.Evictions != 0 {
+				t.Errorf("%d expirations, %d evictions; want 4, 0", s.Expirations, s.Evictions)
+			}
+		})
+	}
+}
+
+func TestStats(t *testing.T) {
+	for name, c := range map[string]Cache[string, int]{
+		"LRU": NewLRU[string, int](2, Options[string, int]{}),
+		"LFU": NewLFU[string, int](2, Options[string, int]{}),
+	} {
+		t.Run(name, func(t *testing.T) {
+			if rate := c.Stats().HitRate(); rate != 0 {
+				t.Errorf("HitRate() = %v without lookups, want 0", rate)
+			}
+			c.Put("a", 1)
+			c.Put("b", 2)
+			c.Get("a")
+			c.Get("a")
+			c.Get("missing")
+			c.Put("c", 3) // evicts b with either policy
+			c.Get("b")
+			want := Stats{Hits: 2, Misses: 2, Evictions: 1}
+			if s := c.Stats(); s != want {
+				t.Errorf("Stats() = %+v, want %+v", s, want)
+			}
+			if rate := c.Stats().HitRate(); rate != 0.5 {
+				t.Errorf("HitRate() = %v, want 0.5", rate)
+			}
+			if c.Delete("b") || !c.Delete("c") {
+				t.Error("Delete reported the wrong presence")
+			}
+			if c.Stats().Evictions != 1 {
+				t.Error("Delete counted as an eviction")
+			}
+		})
+	}
+}
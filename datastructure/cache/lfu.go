@@ -0,0 +1,194 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/dev-crusader/data-structures-and-algorithms/datastructure/generics"
+	"github.com/dev-crusader/data-structures-and-algorithms/datastructure/linkedlist"
+)
+
+// LFU is a cache which evicts the least frequently used entry once it is full. Ties between
+// entries with the same access frequency are broken by evicting the least recently used one.
+//
+// Entries are grouped into one list per access frequency, so Get and Put run in O(1). Delete
+// and the removal of expired entries scan the frequencies in use when they empty the list of
+// the lowest one, Put never does as the entry it adds has the lowest frequency.
+type LFU[K comparable, V any] struct {
+	capacity     int
+	size         int
+	entries      *generics.HashTable[K, *entry[K, V]]
+	frequencies  *generics.HashTable[int, *linkedlist.Deque[*entry[K, V]]] // most recently used first
+	minFrequency int
+	options      Options[K, V]
+	stats        Stats
+}
+
+// NewLFU creates a new LFU cache holding at most capacity entries.
+func NewLFU[K comparable, V any](capacity int, options Options[K, V]) *LFU[K, V] {
+	if capacity < 1 {
+		capacity = 1
+	}
+	options.Clock = clockOrDefault(options.Clock)
+	return &LFU[K, V]{
+		capacity:    capacity,
+		entries:     generics.NewHashTable[K, *entry[K, V]](capacity),
+		frequencies: generics.NewHashTable[int, *linkedlist.Deque[*entry[K, V]]](8),
+		options:     options,
+	}
+}
+
+// Get returns the value cached for the key and increments its access frequency.
+func (c *LFU[K, V]) Get(key K) (V, bool) {
+	e, found := c.entries.Retrieve(key)
+	if found && e.expired(c.options.Clock()) {
+		c.expire(e)
+		found = false
+	}
+	if !found {
+		c.stats.Misses++
+		var zeroValue V
+		return zeroValue, false
+	}
+	c.stats.Hits++
+	c.touch(e)
+	return e.value, true
+}
+
+// Put caches the value for the key using the default TTL of the cache.
+func (c *LFU[K, V]) Put(key K, value V) {
+	c.PutWithTTL(key, value, c.options.TTL)
+}
+
+// PutWithTTL caches the value for the key, expiring it after ttl. A ttl of zero never expires.
+// Updating an existing key counts as an access. If the cache is full the least frequently
+// used entry is evicted.
+func (c *LFU[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	if e, found := c.entries.Retrieve(key); found {
+		e.value = value
+		e.expiresAt = expiry(c.options.Clock, ttl)
+		c.touch(e)
+		return
+	}
+	if c.size >= c.capacity {
+		c.evict(c.listFor(c.minFrequency).Back().Value)
+	}
+	e := &entry[K, V]{key: key, value: value, expiresAt: expiry(c.options.Clock, ttl), frequency: 1}
+	c.entries.Insert(key, e)
+	e.element = c.listFor(1).PushFront(e)
+	c.minFrequency = 1
+	c.size++
+}
+
+// Delete removes the key from the cache without calling the eviction callback.
+func (c *LFU[K, V]) Delete(key K) bool {
+	e, found := c.entries.Retrieve(key)
+	if !found {
+		return false
+	}
+	c.remove(e)
+	return true
+}
+
+// RemoveExpired removes every expired entry and returns how many were removed.
+func (c *LFU[K, V]) RemoveExpired() int {
+	now := c.options.Clock()
+	var expired []*entry[K, V]
+	for _, e := range c.entries.All() {
+		if e.expired(now) {
+			expired = append(expired, e)
+		}
+	}
+	for _, e := range expired {
+		c.expire(e)
+	}
+	return len(expired)
+}
+
+// Len returns the number of cached entries, including expired ones not removed yet.
+func (c *LFU[K, V]) Len() int {
+	return c.size
+}
+
+// Cap returns the maximum number of entries of the cache.
+func (c *LFU[K, V]) Cap() int {
+	return c.capacity
+}
+
+// Stats returns the hit and miss statistics of the cache.
+func (c *LFU[K, V]) Stats() Stats {
+	return c.stats
+}
+
+// listFor returns the list of entries with the given access frequency, creating it if needed.
+func (c *LFU[K, V]) listFor(frequency int) *linkedlist.Deque[*entry[K, V]] {
+	list, _ := c.frequencies.GetOrInsert(frequency, func() *linkedlist.Deque[*entry[K, V]] {
+		return linkedlist.NewDeque[*entry[K, V]]()
+	})
+	return list
+}
+
+// unlink removes the entry from its frequency list, dropping the list once it is empty.
+// It reports whether the list was dropped.
+func (c *LFU[K, V]) unlink(e *entry[K, V]) bool {
+	e.element.Remove()
+	list, _ := c.frequencies.Retrieve(e.frequency)
+	if list.Len() > 0 {
+		return false
+	}
+	c.frequencies.Delete(e.frequency)
+	return true
+}
+
+// touch moves the entry to the list of the next access frequency.
+func (c *LFU[K, V]) touch(e *entry[K, V]) {
+	if c.unlink(e) && e.frequency == c.minFrequency {
+		c.minFrequency++
+	}
+	e.frequency++
+	e.element = c.listFor(e.frequency).PushFront(e)
+}
+
+// detach removes the entry from the cache. It reports whether this emptied the list of the
+// lowest frequency, leaving minFrequency stale.
+func (c *LFU[K, V]) detach(e *entry[K, V]) bool {
+	c.entries.Delete(e.key)
+	c.size--
+	return c.unlink(e) && e.frequency == c.minFrequency
+}
+
+// remove removes the entry from the cache, looking for the new lowest frequency if needed.
+func (c *LFU[K, V]) remove(e *entry[K, V]) {
+	if c.detach(e) {
+		c.minFrequency = c.lowestFrequency()
+	}
+}
+
+// lowestFrequency scans the access frequencies in use for the smallest one. It only runs
+// when the list of the lowest frequency was emptied by Delete or an expiry.
+func (c *LFU[K, V]) lowestFrequency() int {
+	lowest := 0
+	for frequency := range c.frequencies.Keys() {
+		if lowest == 0 || frequency < lowest {
+			lowest = frequency
+		}
+	}
+	return lowest
+}
+
+// evict makes room for a new entry. The entry Put adds next has frequency 1 and resets
+// minFrequency, so there is no need to look for the lowest frequency left.
+func (c *LFU[K, V]) evict(e *entry[K, V]) {
+	c.detach(e)
+	c.stats.Evictions++
+	if c.options.OnEvict != nil {
+		c.options.OnEvict(e.key, e.value)
+	}
+}
+
+func (c *LFU[K, V]) expire(e *entry[K, V]) {
+	c.remove(e)
+	c.stats.Expirations++
+	if c.options.OnEvict != nil {
+		c.options.OnEvict(e.key, e.value)
+	}
+}
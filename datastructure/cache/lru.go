@@ -0,0 +1,130 @@
+package cache
+
+import (
+	"time"
+
+	"github.com/dev-crusader/data-structures-and-algorithms/datastructure/generics"
+	"github.com/dev-crusader/data-structures-and-algorithms/datastructure/linkedlist"
+)
+
+// LRU is a cache which evicts the least recently used entry once it is full.
+type LRU[K comparable, V any] struct {
+	capacity int
+	entries  *generics.HashTable[K, *entry[K, V]]
+	order    linkedlist.Deque[*entry[K, V]] // most recently used first
+	options  Options[K, V]
+	stats    Stats
+}
+
+// NewLRU creates a new LRU cache holding at most capacity entries.
+func NewLRU[K comparable, V any](capacity int, options Options[K, V]) *LRU[K, V] {
+	if capacity < 1 {
+		capacity = 1
+	}
+	options.Clock = clockOrDefault(options.Clock)
+	return &LRU[K, V]{
+		capacity: capacity,
+		entries:  generics.NewHashTable[K, *entry[K, V]](capacity),
+		options:  options,
+	}
+}
+
+// Get returns the value cached for the key and marks it as the most recently used.
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	e, found := c.entries.Retrieve(key)
+	if found && e.expired(c.options.Clock()) {
+		c.expire(e)
+		found = false
+	}
+	if !found {
+		c.stats.Misses++
+		var zeroValue V
+		return zeroValue, false
+	}
+	c.stats.Hits++
+	e.element.MoveToFront()
+	return e.value, true
+}
+
+// Put caches the value for the key using the default TTL of the cache.
+func (c *LRU[K, V]) Put(key K, value V) {
+	c.PutWithTTL(key, value, c.options.TTL)
+}
+
+// PutWithTTL caches the value for the key, expiring it after ttl. A ttl of zero never expires.
+// If the cache is full the least recently used entry is evicted.
+func (c *LRU[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	if e, found := c.entries.Retrieve(key); found {
+		e.value = value
+		e.expiresAt = expiry(c.options.Clock, ttl)
+		e.element.MoveToFront()
+		return
+	}
+	if c.order.Len() >= c.capacity {
+		c.evict(c.order.Back().Value)
+	}
+	e := &entry[K, V]{key: key, value: value, expiresAt: expiry(c.options.Clock, ttl)}
+	c.entries.Insert(key, e)
+	e.element = c.order.PushFront(e)
+}
+
+// Delete removes the key from the cache without calling the eviction callback.
+func (c *LRU[K, V]) Delete(key K) bool {
+	e, found := c.entries.Retrieve(key)
+	if !found {
+		return false
+	}
+	c.remove(e)
+	return true
+}
+
+// RemoveExpired removes every expired entry and returns how many were removed.
+func (c *LRU[K, V]) RemoveExpired() int {
+	now := c.options.Clock()
+	removed := 0
+	for element := c.order.Front(); element != nil; {
+		next := element.Next()
+		if e := element.Value; e.expired(now) {
+			c.expire(e)
+			removed++
+		}
+		element = next
+	}
+	return removed
+}
+
+// Len returns the number of cached entries, including expired ones not removed yet.
+func (c *LRU[K, V]) Len() int {
+	return c.order.Len()
+}
+
+// Cap returns the maximum number of entries of the cache.
+func (c *LRU[K, V]) Cap() int {
+	return c.capacity
+}
+
+// Stats returns the hit and miss statistics of the cache.
+func (c *LRU[K, V]) Stats() Stats {
+	return c.stats
+}
+
+func (c *LRU[K, V]) remove(e *entry[K, V]) {
+	c.entries.Delete(e.key)
+	e.element.Remove()
+}
+
+func (c *LRU[K, V]) evict(e *entry[K, V]) {
+	c.remove(e)
+	c.stats.Evictions++
+	if c.options.OnEvict != nil {
+		c.options.OnEvict(e.key, e.value)
+	}
+}
+
+func (c *LRU[K, V]) expire(e *entry[K, V]) {
+	c.remove(e)
+	c.stats.Expirations++
+	if c.options.OnEvict != nil {
+		c.options.OnEvict(e.key, e.value)
+	}
+}
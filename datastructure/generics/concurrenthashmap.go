@@ -0,0 +1,203 @@
+package generics
+
+import (
+	"fmt"
+	"iter"
+	"math/bits"
+	"sync"
+)
+
+/*
+	ConcurrentHashTable is a HashTable that is safe for use by multiple goroutines.
+
+	The key space is split into shards, each shard being a regular HashTable (buckets of linked lists)
+	guarded by its own sync.RWMutex. A key is routed to its shard using the high bits of its hash while
+	the shard's table uses the same hash to pick a bucket, so goroutines working on different shards never
+	contend and readers of the same shard only take a shared lock.
+
+	Basic Operations:
+	1. Store / Load / Delete
+	2. LoadOrStore   - atomically load the value or store the given one
+	3. LoadAndDelete - atomically delete the key and return its value
+	4. Range         - visit every entry, the callback runs without any shard lock held
+*/
+
+// DefaultShardCount is the number of shards used by NewConcurrentHashTable when none is given.
+const DefaultShardCount = 32
+
+const shardInitialSize = 8
+
+type shard[K comparable, V any] struct {
+	mu    sync.RWMutex
+	table *HashTable[K, V]
+}
+
+// ConcurrentHashTable represents a sharded, concurrency-safe hash table.
+type ConcurrentHashTable[K comparable, V any] struct {
+	shards []*shard[K, V]
+	hasher Hasher[K]
+}
+
+// NewConcurrentHashTable creates a new ConcurrentHashTable with the given number of shards.
+func NewConcurrentHashTable[K comparable, V any](shards int) *ConcurrentHashTable[K, V] {
+	return NewConcurrentHashTableWithHasher[K, V](shards, NewDefaultHasher[K]())
+}
+
+// NewConcurrentHashTableWithHasher creates a new ConcurrentHashTable with the given number of
+// shards which routes keys using the supplied hash function.
+func NewConcurrentHashTableWithHasher[K comparable, V any](shards int, hasher Hasher[K]) *ConcurrentHashTable[K, V] {
+	if shards < 1 {
+		shards = DefaultShardCount
+	}
+	if hasher == nil {
+		hasher = NewDefaultHasher[K]()
+	}
+	ct := &ConcurrentHashTable[K, V]{
+		shards: make([]*shard[K, V], shards),
+		hasher: hasher,
+	}
+	for i := range ct.shards {
+		ct.shards[i] = &shard[K, V]{
+			table: newHashTable[K, V](shardInitialSize, DefaultMaxLoadFactor, DefaultMinLoadFactor, hasher),
+		}
+	}
+	return ct
+}
+
+// shardMixer is 2^64 divided by the golden ratio. Multiplying by it spreads every bit of the
+// hash into the high bits of the product.
+const shardMixer = 0x9e3779b97f4a7c15
+
+// shardFor returns the shard owning the key. The hash is mixed first, so hashers returning
+// small values still spread over all shards, and the shard is picked from the high bits of
+// the mix so the choice is independent of the bucket choice made by the shard's table.
+func (ct *ConcurrentHashTable[K, V]) shardFor(key K) *shard[K, V] {
+	index, _ := bits.Mul64(ct.hasher(key)*shardMixer, uint64(len(ct.shards)))
+	return ct.shards[index]
+}
+
+// Load returns the value stored for the key and whether it was found.
+func (ct *ConcurrentHashTable[K, V]) Load(key K) (V, bool) {
+	s := ct.shardFor(key)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.table.Retrieve(key)
+}
+
+// Store sets the value for the key, replacing any existing value.
+func (ct *ConcurrentHashTable[K, V]) Store(key K, value V) {
+	s := ct.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.table.Put(key, value)
+}
+
+// Delete deletes the key and reports whether it was present.
+func (ct *ConcurrentHashTable[K, V]) Delete(key K) bool {
+	s := ct.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.table.Delete(key)
+}
+
+// LoadOrStore returns the existing value for the key if present. Otherwise it stores and
+// returns the given value. The loaded result is true if the value was loaded.
+func (ct *ConcurrentHashTable[K, V]) LoadOrStore(key K, value V) (actual V, loaded bool) {
+	s := ct.shardFor(key)
+
+	s.mu.RLock()
+	actual, loaded = s.table.Retrieve(key)
+	s.mu.RUnlock()
+	if loaded {
+		return actual, true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.table.GetOrInsert(key, func() V { return value })
+}
+
+// LoadAndDelete deletes the key, returning its previous value if any.
+// The loaded result reports whether the key was present.
+func (ct *ConcurrentHashTable[K, V]) LoadAndDelete(key K) (value V, loaded bool) {
+	s := ct.shardFor(key)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	value, loaded = s.table.Retrieve(key)
+	if loaded {
+		s.table.Delete(key)
+	}
+	return value, loaded
+}
+
+// Range calls f for each key and value in the table, stopping if f returns false.
+// Each shard is copied under its read lock, so f may safely modify the table; it sees
+// a consistent snapshot per shard but not necessarily across shards.
+func (ct *ConcurrentHashTable[K, V]) Range(f func(key K, value V) bool) {
+	for _, s := range ct.shards {
+		s.mu.RLock()
+		entries := make([]HashValue[K, V], 0, s.table.Len())
+		for key, value := range s.table.All() {
+			entries = append(entries, HashValue[K, V]{key: key, value: value})
+		}
+		s.mu.RUnlock()
+
+		for _, entry := range entries {
+			if !f(entry.key, entry.value) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the key-value pairs of the table with the same guarantees as Range.
+func (ct *ConcurrentHashTable[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		ct.Range(yield)
+	}
+}
+
+// Len returns the number of entries stored in the table.
+func (ct *ConcurrentHashTable[K, V]) Len() int {
+	total := 0
+	for _, s := range ct.shards {
+		s.mu.RLock()
+		total += s.table.Len()
+		s.mu.RUnlock()
+	}
+	return total
+}
+
+// ConcurrentMap function to demonstrate the concurrent hash table.
+func ConcurrentMap() {
+	table := NewConcurrentHashTable[int, string](8)
+
+	var wg sync.WaitGroup
+	for worker := 0; worker < 4; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				table.LoadOrStore(i, fmt.Sprintf("stored by worker %d", worker))
+			}
+		}(worker)
+	}
+	wg.Wait()
+	fmt.Println("Len:", table.Len())
+
+	if value, found := table.Load(42); found {
+		fmt.Println("Value for key 42:", value)
+	}
+
+	for i := 0; i < 1000; i += 2 {
+		table.LoadAndDelete(i)
+	}
+	fmt.Println("Len after deleting even keys:", table.Len())
+
+	count := 0
+	table.Range(func(key int, value string) bool {
+		count++
+		return count < 5
+	})
+	fmt.Println("Visited:", count)
+}
@@ -0,0 +1,161 @@
+package generics
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+const (
+	concurrentTestWorkers = 8
+	concurrentTestKeys    = 1000
+)
+
+func TestConcurrentHashTableShardSpread(t *testing.T) {
+	// a hasher returning small values used to send every key to the first shard
+	identity := func(key int) uint64 { return uint64(key) }
+	ct := NewConcurrentHashTableWithHasher[int, int](DefaultShardCount, identity)
+	for i := range concurrentTestKeys {
+		ct.Store(i, i)
+	}
+	for i, s := range ct.shards {
+		if n := s.table.Len(); n == 0 || n > 2*concurrentTestKeys/DefaultShardCount {
+			t.Errorf("shard %d holds %d of %d keys", i, n, concurrentTestKeys)
+		}
+	}
+}
+
+func TestConcurrentHashTableLoadOrStore(t *testing.T) {
+	ct := NewConcurrentHashTable[int, int](0)
+	var stored [concurrentTestKeys]atomic.Int32
+	actuals := make([][]int, concurrentTestWorkers)
+
+	var wg sync.WaitGroup
+	for worker := range concurrentTestWorkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			actuals[worker] = make([]int, concurrentTestKeys)
+			for i := range concurrentTestKeys {
+				actual, loaded := ct.LoadOrStore(i, worker)
+				if !loaded {
+					stored[i].Add(1)
+				}
+				actuals[worker][i] = actual
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i := range concurrentTestKeys {
+		if n := stored[i].Load(); n != 1 {
+			t.Errorf("key %d stored %d times, want once", i, n)
+		}
+		want, _ := ct.Load(i)
+		for worker := range concurrentTestWorkers {
+			if actuals[worker][i] != want {
+				t.Errorf("worker %d got %d for key %d, the table holds %d", worker, actuals[worker][i], i, want)
+			}
+		}
+	}
+	if ct.Len() != concurrentTestKeys {
+		t.Errorf("Len() = %d, want %d", ct.Len(), concurrentTestKeys)
+	}
+}
+
+func TestConcurrentHashTableLoadAndDelete(t *testing.T) {
+	ct := NewConcurrentHashTable[int, int](0)
+	for i := range concurrentTestKeys {
+		ct.Store(i, -i)
+	}
+	var deleted [concurrentTestKeys]atomic.Int32
+
+	var wg sync.WaitGroup
+	for range concurrentTestWorkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range concurrentTestKeys {
+				value, loaded := ct.LoadAndDelete(i)
+				if !loaded {
+					continue
+				}
+				if value != -i {
+					t.Errorf("LoadAndDelete(%d) = %d, want %d", i, value, -i)
+				}
+				deleted[i].Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i := range concurrentTestKeys {
+		if n := deleted[i].Load(); n != 1 {
+			t.Errorf("key %d deleted %d times, want once", i, n)
+		}
+	}
+	if ct.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", ct.Len())
+	}
+}
+
+func TestConcurrentHashTableRange(t *testing.T) {
+	ct := NewConcurrentHashTable[int, int](4)
+	for i := range concurrentTestKeys {
+		ct.Store(i, i)
+	}
+
+	// writers only touch keys above concurrentTestKeys, so Range must always see the first ones
+	var wg sync.WaitGroup
+	for worker := range concurrentTestWorkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range concurrentTestKeys {
+				key := concurrentTestKeys*(worker+1) + i
+				ct.Store(key, key)
+				ct.Delete(key)
+			}
+		}()
+	}
+	for range concurrentTestWorkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			seen := 0
+			ct.Range(func(key, value int) bool {
+				if key != value {
+					t.Errorf("Range visited %d with value %d", key, value)
+				}
+				if key < concurrentTestKeys {
+					seen++
+				}
+				return true
+			})
+			if seen != concurrentTestKeys {
+				t.Errorf("Range visited %d of the %d stable keys", seen, concurrentTestKeys)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// the callback runs without a lock held, so it may write to the table
+	ct.Range(func(key, value int) bool {
+		ct.Store(key, value+1)
+		return true
+	})
+	for i := range concurrentTestKeys {
+		if v, _ := ct.Load(i); v != i+1 {
+			t.Fatalf("Load(%d) = %d after updating from Range, want %d", i, v, i+1)
+		}
+	}
+
+	visited := 0
+	ct.Range(func(int, int) bool {
+		visited++
+		return visited < 10
+	})
+	if visited != 10 {
+		t.Errorf("Range visited %d entries after returning false, want 10", visited)
+	}
+}
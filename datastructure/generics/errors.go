@@ -0,0 +1,25 @@
+package generics
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrIndexOutOfRange is returned by the list operations when an index is outside of the list.
+var ErrIndexOutOfRange = errors.New("generics: index out of range")
+
+// IndexError reports an index outside of a list along with the length of the list.
+// It matches ErrIndexOutOfRange with errors.Is.
+type IndexError struct {
+	Index int
+	Len   int
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("%v: %d with length %d", ErrIndexOutOfRange, e.Index, e.Len)
+}
+
+// Is reports whether target is ErrIndexOutOfRange.
+func (e *IndexError) Is(target error) bool {
+	return target == ErrIndexOutOfRange
+}
@@ -0,0 +1,84 @@
+package generics
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"math"
+)
+
+/*
+	Hashing layer used by the HashTable to spread keys across its buckets.
+
+	The default hasher is seeded once per table through hash/maphash, so bucket placement differs between
+	tables and processes. Common key kinds (strings, integers, floats, booleans) are hashed directly from
+	their bytes, any other comparable key is hashed by value through maphash.Comparable.
+	A user-supplied Hasher can be passed to NewHashTableWithHasher when a deterministic hash is needed,
+	e.g. for struct keys.
+*/
+
+// Hasher computes a 64 bit hash for a key. Equal keys must produce equal hashes.
+type Hasher[K comparable] func(key K) uint64
+
+// NewDefaultHasher returns a Hasher seeded with a random maphash seed.
+func NewDefaultHasher[K comparable]() Hasher[K] {
+	seed := maphash.MakeSeed()
+	return func(key K) uint64 {
+		return hashWithSeed(seed, key)
+	}
+}
+
+func hashWithSeed[K comparable](seed maphash.Seed, key K) uint64 {
+	switch k := any(key).(type) {
+	case string:
+		return maphash.String(seed, k)
+	case int:
+		return hashUint64(seed, uint64(k))
+	case int8:
+		return hashUint64(seed, uint64(k))
+	case int16:
+		return hashUint64(seed, uint64(k))
+	case int32:
+		return hashUint64(seed, uint64(k))
+	case int64:
+		return hashUint64(seed, uint64(k))
+	case uint:
+		return hashUint64(seed, uint64(k))
+	case uint8:
+		return hashUint64(seed, uint64(k))
+	case uint16:
+		return hashUint64(seed, uint64(k))
+	case uint32:
+		return hashUint64(seed, uint64(k))
+	case uint64:
+		return hashUint64(seed, k)
+	case uintptr:
+		return hashUint64(seed, uint64(k))
+	case float32:
+		return hashFloat(seed, float64(k))
+	case float64:
+		return hashFloat(seed, k)
+	case bool:
+		if k {
+			return hashUint64(seed, 1)
+		}
+		return hashUint64(seed, 0)
+	default:
+		// hashes the value itself, so equal structs, arrays and interfaces hash equally,
+		// e.g. ones holding +0 and -0
+		return maphash.Comparable(seed, key)
+	}
+}
+
+func hashUint64(seed maphash.Seed, v uint64) uint64 {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], v)
+	return maphash.Bytes(seed, buf[:])
+}
+
+func hashFloat(seed maphash.Seed, f float64) uint64 {
+	if f == 0 {
+		// +0 and -0 are equal keys and must land in the same bucket
+		f = 0
+	}
+	return hashUint64(seed, math.Float64bits(f))
+}
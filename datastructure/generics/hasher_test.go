@@ -0,0 +1,55 @@
+package generics
+
+import (
+	"math"
+	"testing"
+)
+
+type point struct {
+	X, Y float64
+}
+
+func TestDefaultHasherEqualKeys(t *testing.T) {
+	negativeZero := math.Copysign(0, -1)
+
+	t.Run("float", func(t *testing.T) {
+		h := NewDefaultHasher[float64]()
+		if h(0) != h(negativeZero) {
+			t.Error("+0 and -0 hash differently")
+		}
+	})
+	t.Run("struct", func(t *testing.T) {
+		h := NewDefaultHasher[point]()
+		if h(point{0, 1}) != h(point{negativeZero, 1}) {
+			t.Error("structs holding +0 and -0 hash differently")
+		}
+	})
+	t.Run("array", func(t *testing.T) {
+		h := NewDefaultHasher[[2]string]()
+		if h([2]string{"a", "b"}) != h([2]string{"a", "b"}) {
+			t.Error("equal arrays hash differently")
+		}
+	})
+	t.Run("interface", func(t *testing.T) {
+		h := NewDefaultHasher[any]()
+		if h(any(point{0, 1})) != h(any(point{negativeZero, 1})) {
+			t.Error("equal interface values hash differently")
+		}
+	})
+}
+
+func TestHashTableStructKeys(t *testing.T) {
+	ht := NewHashTable[point, int](4)
+	ht.Insert(point{0, 0}, 1)
+	if v, ok := ht.Retrieve(point{math.Copysign(0, -1), 0}); !ok || v != 1 {
+		t.Errorf("Retrieve(-0, 0) = %d, %t; want 1, true", v, ok)
+	}
+}
+
+func BenchmarkDefaultHasherStruct(b *testing.B) {
+	h := NewDefaultHasher[point]()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		h(point{float64(i), 1})
+	}
+}
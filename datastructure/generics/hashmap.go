@@ -2,6 +2,9 @@ package generics
 
 import (
 	"fmt"
+	"iter"
+	"maps"
+	"slices"
 )
 
 /*
@@ -10,13 +13,34 @@ import (
 	key type and stores values of any (interface) type, making it versatile for various use cases.
 	It uses hash function to compute the index for each key, facilitating efficient data retrieval by mapping
 	keys to their corresponding array indices. Values are stored into the linkedlist.
+	The hash function is pluggable, see hasher.go for the default seeded hasher.
+
+	The table keeps track of the number of stored entries and grows (doubles its bucket array) whenever
+	the load factor, i.e. entries per bucket, exceeds the configured maximum. Optionally it also shrinks
+	back (halves the bucket array) once the load factor drops below a minimum after deletes.
 
 	Basic Operations:
 	1. Insert
 	2. Retrieve
 	3. Delete
+
+	Upsert Operations:
+	1. Put            - insert or replace, returns the previous value
+	2. Update         - replace the value with the result of a function of the old value
+	3. GetOrInsert    - return the stored value or insert one built by a factory
+	4. CompareAndSwap - replace the value only if it equals the expected one
+
+	Iteration:
+	All, Keys and Values return range-over-func iterators, the order of the entries is unspecified.
 */
 
+const (
+	// DefaultMaxLoadFactor is the load factor above which the table grows.
+	DefaultMaxLoadFactor = 0.75
+	// DefaultMinLoadFactor is the load factor below which the table shrinks, zero disables shrinking.
+	DefaultMinLoadFactor = 0.0
+)
+
 // HashValue represents a key-value pair.
 type HashValue[K comparable, V any] struct {
 	key   K
@@ -34,33 +58,43 @@ type LinkedList[K comparable, V any] struct {
 	head *Node[K, V]
 }
 
-// Insert adds a new node to the linked list.
-func (ll *LinkedList[K, V]) Insert(hashValue *HashValue[K, V]) {
-	if _, found := ll.Find(hashValue.key); found {
-		fmt.Printf("Insert Key: %v already exists\n", hashValue.key)
-		return
+// Insert adds a new node to the linked list and reports whether it was added.
+// The list is left untouched if the key already exists.
+func (ll *LinkedList[K, V]) Insert(hashValue *HashValue[K, V]) bool {
+	if ll.findNode(hashValue.key) != nil {
+		return false
 	}
 	newNode := &Node[K, V]{hashValue: hashValue}
 	newNode.next = ll.head
 	ll.head = newNode
+	return true
 }
 
 // Find searches for a key in the linked list and returns its value if found.
 func (ll *LinkedList[K, V]) Find(key K) (V, bool) {
-	current := ll.head
+	if node := ll.findNode(key); node != nil {
+		return node.hashValue.value, true
+	}
 	var zeroValue V // zero value of type V
-	for current != nil {
+	return zeroValue, false
+}
+
+// findNode returns the node holding the key, or nil if the key is not in the list.
+func (ll *LinkedList[K, V]) findNode(key K) *Node[K, V] {
+	for current := ll.head; current != nil; current = current.next {
 		if current.hashValue.key == key {
-			return current.hashValue.value, true
+			return current
 		}
-		current = current.next
 	}
-	return zeroValue, false
+	return nil
 }
 
 // Delete deletes the node from the linkedlist if present
 func (ll *LinkedList[K, V]) Delete(key K) bool {
 	current := ll.head
+	if current == nil {
+		return false
+	}
 
 	if current.hashValue.key == key {
 		ll.head = current.next
@@ -78,29 +112,137 @@ func (ll *LinkedList[K, V]) Delete(key K) bool {
 
 // HashTable represents the hash table.
 type HashTable[K comparable, V any] struct {
-	buckets []*LinkedList[K, V]
-	size    int
+	buckets       []*LinkedList[K, V]
+	size          int // number of buckets
+	minSize       int // bucket count the table never shrinks below
+	count         int // number of stored entries
+	maxLoadFactor float64
+	minLoadFactor float64
+	hasher        Hasher[K]
 }
 
-// NewHashTable creates a new HashTable with a specified size.
+// NewHashTable creates a new HashTable with a specified size and the default load factors.
 func NewHashTable[K comparable, V any](size int) *HashTable[K, V] {
+	return NewHashTableWithLoadFactor[K, V](size, DefaultMaxLoadFactor, DefaultMinLoadFactor)
+}
+
+// NewHashTableWithLoadFactor creates a new HashTable with a specified size which grows once
+// the load factor exceeds maxLoadFactor and shrinks once it drops below minLoadFactor.
+// A minLoadFactor of zero disables shrinking, a minLoadFactor of maxLoadFactor/2 or more is
+// clamped to maxLoadFactor/4 so that a shrink is never immediately followed by a grow.
+func NewHashTableWithLoadFactor[K comparable, V any](size int, maxLoadFactor, minLoadFactor float64) *HashTable[K, V] {
+	return newHashTable[K, V](size, maxLoadFactor, minLoadFactor, NewDefaultHasher[K]())
+}
+
+// NewHashTableWithHasher creates a new HashTable with a specified size which places
+// keys into buckets using the supplied hash function.
+func NewHashTableWithHasher[K comparable, V any](size int, hasher Hasher[K]) *HashTable[K, V] {
+	if hasher == nil {
+		hasher = NewDefaultHasher[K]()
+	}
+	return newHashTable[K, V](size, DefaultMaxLoadFactor, DefaultMinLoadFactor, hasher)
+}
+
+func newHashTable[K comparable, V any](size int, maxLoadFactor, minLoadFactor float64, hasher Hasher[K]) *HashTable[K, V] {
+	if size < 1 {
+		size = 1
+	}
+	if maxLoadFactor <= 0 {
+		maxLoadFactor = DefaultMaxLoadFactor
+	}
+	if minLoadFactor < 0 {
+		minLoadFactor = DefaultMinLoadFactor
+	}
+	if minLoadFactor >= maxLoadFactor/2 {
+		// a shrink threshold too close to the grow threshold would make the table thrash
+		minLoadFactor = maxLoadFactor / 4
+	}
+	return &HashTable[K, V]{
+		buckets:       newBuckets[K, V](size),
+		size:          size,
+		minSize:       size,
+		maxLoadFactor: maxLoadFactor,
+		minLoadFactor: minLoadFactor,
+		hasher:        hasher,
+	}
+}
+
+func newBuckets[K comparable, V any](size int) []*LinkedList[K, V] {
 	buckets := make([]*LinkedList[K, V], size)
 	for i := range buckets {
 		buckets[i] = &LinkedList[K, V]{}
 	}
-	return &HashTable[K, V]{buckets: buckets, size: size}
+	return buckets
 }
 
 // Hash function to compute the index for a given key.
 func (ht *HashTable[K, V]) hash(key K) int {
-	return int(fmt.Sprintf("%v", key)[0]) % ht.size
+	return ht.indexFor(key, ht.size)
 }
 
-// Insert inserts a new key-value pair into the hash table.
-func (ht *HashTable[K, V]) Insert(key K, value V) {
+// indexFor computes the bucket index of a key for a table with the given number of buckets.
+func (ht *HashTable[K, V]) indexFor(key K, size int) int {
+	return int(ht.hasher(key) % uint64(size))
+}
+
+// Insert inserts a new key-value pair into the hash table and reports whether it was added.
+// An existing value is never overwritten, use Put for that.
+func (ht *HashTable[K, V]) Insert(key K, value V) bool {
 	hashValue := &HashValue[K, V]{key: key, value: value}
 	index := ht.hash(key)
-	ht.buckets[index].Insert(hashValue)
+	if !ht.buckets[index].Insert(hashValue) {
+		return false
+	}
+	ht.onInsert()
+	return true
+}
+
+// Put inserts the key-value pair, replacing the value if the key already exists.
+// It returns the previous value and whether the key was present.
+func (ht *HashTable[K, V]) Put(key K, value V) (V, bool) {
+	if node := ht.buckets[ht.hash(key)].findNode(key); node != nil {
+		previous := node.hashValue.value
+		node.hashValue.value = value
+		return previous, true
+	}
+	ht.Insert(key, value)
+	var zeroValue V
+	return zeroValue, false
+}
+
+// Update stores the value returned by fn for the key and returns it. fn receives the
+// current value and whether the key was present, so it can also be used to insert.
+func (ht *HashTable[K, V]) Update(key K, fn func(old V, ok bool) V) V {
+	if node := ht.buckets[ht.hash(key)].findNode(key); node != nil {
+		node.hashValue.value = fn(node.hashValue.value, true)
+		return node.hashValue.value
+	}
+	var zeroValue V
+	value := fn(zeroValue, false)
+	ht.Insert(key, value)
+	return value
+}
+
+// GetOrInsert returns the value stored for the key if present. Otherwise it inserts the
+// value built by factory and returns it. The boolean reports whether the value was loaded.
+func (ht *HashTable[K, V]) GetOrInsert(key K, factory func() V) (V, bool) {
+	if node := ht.buckets[ht.hash(key)].findNode(key); node != nil {
+		return node.hashValue.value, true
+	}
+	value := factory()
+	ht.Insert(key, value)
+	return value, false
+}
+
+// CompareAndSwap replaces the value stored for the key with new if the stored value equals old,
+// and reports whether the swap happened. Like sync.Map, it panics if the values are not comparable.
+func (ht *HashTable[K, V]) CompareAndSwap(key K, old, new V) bool {
+	node := ht.buckets[ht.hash(key)].findNode(key)
+	if node == nil || any(node.hashValue.value) != any(old) {
+		return false
+	}
+	node.hashValue.value = new
+	return true
 }
 
 // Retrieve retrieves a value by key from the hash table and returns it if found.
@@ -109,10 +251,106 @@ func (ht *HashTable[K, V]) Retrieve(key K) (V, bool) {
 	return ht.buckets[index].Find(key)
 }
 
+// Get is an alias of Retrieve which satisfies the Map interface.
+func (ht *HashTable[K, V]) Get(key K) (V, bool) {
+	return ht.Retrieve(key)
+}
+
 // Delete deletes a value by key from the hash table if present.
 func (ht *HashTable[K, V]) Delete(key K) bool {
 	index := ht.hash(key)
-	return ht.buckets[index].Delete(key)
+	if !ht.buckets[index].Delete(key) {
+		return false
+	}
+	ht.count--
+	if ht.LoadFactor() < ht.minLoadFactor && ht.size/2 >= ht.minSize {
+		ht.resize(ht.size / 2)
+	}
+	return true
+}
+
+// All returns an iterator over the key-value pairs of the hash table.
+// The table must not be modified while iterating, except for deleting the current key.
+func (ht *HashTable[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, bucket := range ht.buckets {
+			for current := bucket.head; current != nil; {
+				next := current.next
+				if !yield(current.hashValue.key, current.hashValue.value) {
+					return
+				}
+				current = next
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over the keys of the hash table.
+func (ht *HashTable[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range ht.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values of the hash table.
+func (ht *HashTable[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range ht.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// onInsert records a newly added entry and grows the table once the load factor is exceeded.
+func (ht *HashTable[K, V]) onInsert() {
+	ht.count++
+	if ht.LoadFactor() > ht.maxLoadFactor {
+		ht.resize(ht.size * 2)
+	}
+}
+
+// Len returns the number of entries stored in the hash table.
+func (ht *HashTable[K, V]) Len() int {
+	return ht.count
+}
+
+// Cap returns the current number of buckets of the hash table.
+func (ht *HashTable[K, V]) Cap() int {
+	return ht.size
+}
+
+// LoadFactor returns the average number of entries per bucket.
+func (ht *HashTable[K, V]) LoadFactor() float64 {
+	return float64(ht.count) / float64(ht.size)
+}
+
+// resize rehashes every entry into a new bucket array with the given number of buckets.
+// Growing relinks the existing nodes. Shrinking, which Delete may trigger from inside an All
+// loop, links copies instead, so the loop keeps walking the unchanged old chains.
+func (ht *HashTable[K, V]) resize(size int) {
+	buckets := newBuckets[K, V](size)
+	shrinking := size < ht.size
+	for _, bucket := range ht.buckets {
+		for current := bucket.head; current != nil; {
+			next := current.next
+			node := current
+			if shrinking {
+				node = &Node[K, V]{hashValue: current.hashValue}
+			}
+			index := ht.indexFor(node.hashValue.key, size)
+			node.next = buckets[index].head
+			buckets[index].head = node
+			current = next
+		}
+	}
+	ht.buckets = buckets
+	ht.size = size
 }
 
 type Student struct {
@@ -136,7 +374,9 @@ func GenericMap() {
 	stringHashTable := NewHashTable[string, string](10)
 	stringHashTable.Insert("a", "hello")
 	stringHashTable.Insert("b", "world")
-	stringHashTable.Insert("a", "world")
+	if !stringHashTable.Insert("a", "world") {
+		fmt.Println("Insert Key: a already exists")
+	}
 
 	// Create a hash table for string keys and int values.
 	intHashTable := NewHashTable[string, int](10)
@@ -175,7 +415,9 @@ func GenericMap() {
 	infoHashTable.Insert("132", NewStudent(24, "132", "Railey", "Houston"))
 	infoHashTable.Insert("543", NewStudent(22, "543", "Matt", "Florida"))
 	infoHashTable.Insert("172", NewStudent(24, "172", "Bailey", "Colorado"))
-	infoHashTable.Insert("123", NewStudent(21, "123", "Jill", "Seattle"))
+	if !infoHashTable.Insert("123", NewStudent(21, "123", "Jill", "Seattle")) {
+		fmt.Println("Insert Key: 123 already exists")
+	}
 	infoHashTable.Insert("863", NewStudent(21, "863", "Corey", "New York"))
 
 	// Looking up keys in the student hash table.
@@ -199,4 +441,47 @@ func GenericMap() {
 	// Deleting the student info from the hash table
 	fmt.Printf("Deleted: %t\n", infoHashTable.Delete("132"))
 	fmt.Printf("Deleted: %t\n", infoHashTable.Delete("100"))
+
+	// Growing the hash table past its load factor and shrinking it back after deletes.
+	resizableHashTable := NewHashTableWithLoadFactor[int, int](4, 0.75, 0.25)
+	for i := 0; i < 100; i++ {
+		resizableHashTable.Insert(i, i*i)
+	}
+	fmt.Printf("Len: %d, Cap: %d, LoadFactor: %.2f\n",
+		resizableHashTable.Len(), resizableHashTable.Cap(), resizableHashTable.LoadFactor())
+	for i := 0; i < 95; i++ {
+		resizableHashTable.Delete(i)
+	}
+	fmt.Printf("Len: %d, Cap: %d, LoadFactor: %.2f\n",
+		resizableHashTable.Len(), resizableHashTable.Cap(), resizableHashTable.LoadFactor())
+
+	// Hashing struct keys deterministically with a user-supplied hash function.
+	type point struct{ x, y int }
+	pointHashTable := NewHashTableWithHasher[point, string](8, func(p point) uint64 {
+		return uint64(p.x)*31 + uint64(p.y)
+	})
+	pointHashTable.Insert(point{1, 2}, "first")
+	pointHashTable.Insert(point{2, 1}, "second")
+	if value, found := pointHashTable.Retrieve(point{2, 1}); found {
+		fmt.Printf("Value for key {2 1} in point hash table: %v\n", value)
+	}
+
+	// Overwriting and updating entries in place.
+	counter := NewHashTable[string, int](8)
+	for _, word := range []string{"go", "map", "go", "list", "go", "map"} {
+		counter.Update(word, func(old int, ok bool) int { return old + 1 })
+	}
+	previous, replaced := counter.Put("list", 10)
+	fmt.Printf("Put list: previous %d, replaced %t\n", previous, replaced)
+	fmt.Printf("CompareAndSwap go 3->0: %t\n", counter.CompareAndSwap("go", 3, 0))
+	value, loaded := counter.GetOrInsert("stack", func() int { return 1 })
+	fmt.Printf("GetOrInsert stack: %d, loaded %t\n", value, loaded)
+
+	// Iterating over the entries with range-over-func.
+	for word, count := range counter.All() {
+		fmt.Printf("%s: %d\n", word, count)
+	}
+	words := slices.Sorted(counter.Keys())
+	fmt.Println("Words:", words)
+	fmt.Println("Collected:", maps.Collect(counter.All()))
 }
@@ -0,0 +1,69 @@
+package generics
+
+import "testing"
+
+func TestHashTableLoadFactorClamp(t *testing.T) {
+	tests := []struct {
+		name          string
+		maxLoadFactor float64
+		minLoadFactor float64
+		want          float64
+	}{
+		{"valid", 0.75, 0.25, 0.25},
+		{"disabled", 0.75, 0, 0},
+		{"negative", 0.75, -1, 0},
+		{"half of max", 0.8, 0.4, 0.2},
+		{"above max", 0.8, 0.9, 0.2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ht := NewHashTableWithLoadFactor[int, int](4, tt.maxLoadFactor, tt.minLoadFactor)
+			if ht.minLoadFactor != tt.want {
+				t.Errorf("minLoadFactor = %v, want %v", ht.minLoadFactor, tt.want)
+			}
+		})
+	}
+}
+
+func TestHashTableShrinkWithClampedLoadFactor(t *testing.T) {
+	ht := NewHashTableWithLoadFactor[int, int](4, 0.75, 0.75)
+	for i := range 1000 {
+		ht.Insert(i, i)
+	}
+	if ht.size <= ht.minSize {
+		t.Fatalf("%d buckets after 1000 inserts, want the table grown", ht.size)
+	}
+	for i := range 1000 {
+		ht.Delete(i)
+	}
+	if ht.size != ht.minSize {
+		t.Errorf("%d buckets after deleting every key, want the table shrunk back to %d", ht.size, ht.minSize)
+	}
+}
+
+func TestHashTableDeleteWhileIterating(t *testing.T) {
+	ht := NewHashTableWithLoadFactor[int, int](4, 0.75, 0.25)
+	for i := range 1000 {
+		ht.Insert(i, i)
+	}
+
+	visited := make(map[int]bool)
+	for key := range ht.All() {
+		if visited[key] {
+			t.Fatalf("key %d visited twice", key)
+		}
+		visited[key] = true
+		if !ht.Delete(key) {
+			t.Fatalf("Delete(%d) = false", key)
+		}
+	}
+	if len(visited) != 1000 {
+		t.Errorf("visited %d keys, want 1000", len(visited))
+	}
+	if ht.Len() != 0 {
+		t.Errorf("Len() = %d after deleting every key, want 0", ht.Len())
+	}
+	if ht.size != ht.minSize {
+		t.Errorf("%d buckets after deleting every key, want the table shrunk back to %d", ht.size, ht.minSize)
+	}
+}
@@ -1,9 +1,16 @@
 package generics
 
-import "fmt"
+import (
+	"fmt"
+	"iter"
+)
 
+// List is a generic singly linked list. It keeps a pointer to its tail, so appending is O(1).
+// The zero value is an empty list ready to use.
 type List[T comparable] struct {
 	head *Nodes[T]
+	tail *Nodes[T]
+	size int
 }
 
 type Nodes[T comparable] struct {
@@ -11,16 +18,125 @@ type Nodes[T comparable] struct {
 	next *Nodes[T]
 }
 
+// Push appends v to the end of the list.
 func (a *List[T]) Push(v T) {
+	node := &Nodes[T]{data: v}
 	if a.head == nil {
-		a.head = &Nodes[T]{data: v}
+		a.head = node
 	} else {
-		currentNode := a.head
-		for currentNode.next != nil {
-			currentNode = currentNode.next
+		a.tail.next = node
+	}
+	a.tail = node
+	a.size++
+}
+
+// InsertAt inserts v at the given index, shifting the following elements back.
+// Any index from 0 to Len is valid, Len appends to the list.
+func (a *List[T]) InsertAt(index int, v T) error {
+	if index < 0 || index > a.size {
+		return &IndexError{Index: index, Len: a.size}
+	}
+	if index == a.size {
+		a.Push(v)
+		return nil
+	}
+	node := &Nodes[T]{data: v}
+	if index == 0 {
+		node.next = a.head
+		a.head = node
+	} else {
+		prev := a.nodeAt(index - 1)
+		node.next = prev.next
+		prev.next = node
+	}
+	a.size++
+	return nil
+}
+
+// nodeAt returns the node at the given index, which must be in range.
+func (a *List[T]) nodeAt(index int) *Nodes[T] {
+	node := a.head
+	for ; index > 0; index-- {
+		node = node.next
+	}
+	return node
+}
+
+// Remove removes the first occurrence of key from the list and reports whether it was found.
+func (a *List[T]) Remove(key T) bool {
+	var prev *Nodes[T]
+	for current := a.head; current != nil; prev, current = current, current.next {
+		if current.data != key {
+			continue
+		}
+		if prev == nil {
+			a.head = current.next
+		} else {
+			prev.next = current.next
+		}
+		if current == a.tail {
+			a.tail = prev
+		}
+		a.size--
+		return true
+	}
+	return false
+}
+
+// IndexOf returns the index of the first occurrence of v, or -1 if it is not in the list.
+func (a *List[T]) IndexOf(v T) int {
+	index := 0
+	for i := a.head; i != nil; i = i.next {
+		if i.data == v {
+			return index
+		}
+		index++
+	}
+	return -1
+}
+
+// Contains reports whether v is in the list.
+func (a *List[T]) Contains(v T) bool {
+	return a.IndexOf(v) >= 0
+}
+
+// Reverse reverses the list in place.
+func (a *List[T]) Reverse() {
+	var prev *Nodes[T]
+	current := a.head
+	a.tail = a.head
+	for current != nil {
+		next := current.next
+		current.next = prev
+		prev = current
+		current = next
+	}
+	a.head = prev
+}
+
+// Len returns the number of elements in the list.
+func (a *List[T]) Len() int {
+	return a.size
+}
+
+// Filter returns a new list holding the elements for which keep returns true.
+func (a *List[T]) Filter(keep func(T) bool) *List[T] {
+	result := &List[T]{}
+	for i := a.head; i != nil; i = i.next {
+		if keep(i.data) {
+			result.Push(i.data)
 		}
-		currentNode.next = &Nodes[T]{data: v}
 	}
+	return result
+}
+
+// MapList returns a new list holding the result of fn for every element of the list.
+func MapList[T, U comparable](a *List[T], fn func(T) U) *List[U] {
+	result := &List[U]{}
+	for i := a.head; i != nil; i = i.next {
+		result.Push(fn(i.data))
+	}
+	return result
 }
 
 func (a *List[T]) GetAll() []T {
@@ -31,25 +147,26 @@ func (a *List[T]) GetAll() []T {
 	return res
 }
 
-func (a *List[T]) delete(key T) {
-	if a.head == nil {
-		return
-	}
-	searchFrom := a.head
-	prev := &Nodes[T]{}
-	if searchFrom.data == key {
-		a.head = searchFrom.next
-		return
-	} else {
-		for searchFrom.next != nil && searchFrom.data != key {
-			prev = searchFrom
-			searchFrom = searchFrom.next
+// All returns an iterator over the elements of the list from head to tail.
+func (a *List[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := a.head; i != nil; i = i.next {
+			if !yield(i.data) {
+				return
+			}
 		}
-		if searchFrom.data == key {
-			prev.next = searchFrom.next
-			searchFrom = nil
-		} else {
-			fmt.Printf("\nNot found: %+v\n", key)
+	}
+}
+
+// Backward returns an iterator over the elements of the list from tail to head.
+// As the list is singly linked, the elements are buffered before being yielded.
+func (a *List[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		values := a.GetAll()
+		for i := len(values) - 1; i >= 0; i-- {
+			if !yield(values[i]) {
+				return
+			}
 		}
 	}
 }
@@ -72,13 +189,34 @@ func RunLinkedList() {
 	k.Push("ham")
 	k.Push("pam")
 	fmt.Println("list:", k.GetAll())
-	k.delete("zam")
+	k.Remove("zam")
 	fmt.Println("list:", k.GetAll())
 
-	lst.delete(10)
-	lst.delete(-34)
+	lst.Remove(10)
+	lst.Remove(-34)
 	lst.Push(12)
 	fmt.Println("list:", lst.GetAll())
-	lst.delete(12)
+	lst.Remove(12)
 	fmt.Println("list:", lst.GetAll())
+	fmt.Println("removed missing:", lst.Remove(99))
+
+	for v := range k.Backward() {
+		fmt.Print(v, " ")
+	}
+	fmt.Println()
+
+	if err := lst.InsertAt(1, 7); err != nil {
+		fmt.Println(err)
+	}
+	if err := lst.InsertAt(10, 8); err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println("list:", lst.GetAll(), "len:", lst.Len())
+	fmt.Println("index of 23:", lst.IndexOf(23), "contains 5:", lst.Contains(5))
+	lst.Reverse()
+	fmt.Println("reversed:", lst.GetAll())
+	evens := lst.Filter(func(v int) bool { return v%2 == 0 })
+	fmt.Println("evens:", evens.GetAll())
+	labels := MapList(&lst, func(v int) string { return fmt.Sprintf("#%d", v) })
+	fmt.Println("labels:", labels.GetAll())
 }
@@ -0,0 +1,166 @@
+package generics
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func listOf[T comparable](values ...T) *List[T] {
+	l := &List[T]{}
+	for _, v := range values {
+		l.Push(v)
+	}
+	return l
+}
+
+// checkList verifies that the tail and the size agree with the chain of nodes.
+func checkList[T comparable](t *testing.T, l *List[T]) {
+	t.Helper()
+	var last *Nodes[T]
+	n := 0
+	for node := l.head; node != nil; node = node.next {
+		last = node
+		n++
+	}
+	if l.tail != last {
+		t.Fatal("tail is not the last node")
+	}
+	if l.size != n {
+		t.Fatalf("size %d, %d nodes linked", l.size, n)
+	}
+	if l.Len() != n {
+		t.Fatalf("Len() = %d, %d nodes linked", l.Len(), n)
+	}
+}
+
+// checkValues verifies the list and its values, then appends to it to make sure the tail
+// pointer still leads to the end of the list.
+func checkValues(t *testing.T, l *List[int], want ...int) {
+	t.Helper()
+	checkList(t, l)
+	if got := l.GetAll(); !slices.Equal(got, want) {
+		t.Fatalf("GetAll() = %v, want %v", got, want)
+	}
+	l.Push(100)
+	checkList(t, l)
+	if got := l.GetAll(); !slices.Equal(got, append(slices.Clone(want), 100)) {
+		t.Fatalf("GetAll() after Push(100) = %v, want %v followed by 100", got, want)
+	}
+	l.Remove(100)
+}
+
+func TestListRemove(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		remove int
+		found  bool
+		want   []int
+	}{
+		{"head", []int{1, 2, 3}, 1, true, []int{2, 3}},
+		{"middle", []int{1, 2, 3}, 2, true, []int{1, 3}},
+		{"tail", []int{1, 2, 3}, 3, true, []int{1, 2}},
+		{"only element", []int{1}, 1, true, nil},
+		{"first occurrence", []int{1, 2, 1}, 1, true, []int{2, 1}},
+		{"missing", []int{1, 2, 3}, 4, false, []int{1, 2, 3}},
+		{"empty", nil, 1, false, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := listOf(tt.values...)
+			if found := l.Remove(tt.remove); found != tt.found {
+				t.Errorf("Remove(%d) = %t, want %t", tt.remove, found, tt.found)
+			}
+			checkValues(t, l, tt.want...)
+		})
+	}
+}
+
+func TestListRemoveUntilEmpty(t *testing.T) {
+	l := listOf(1, 2, 3)
+	l.Remove(3)
+	l.Remove(1)
+	checkValues(t, l, 2)
+	l.Remove(2)
+	checkValues(t, l)
+	if l.head != nil || l.tail != nil {
+		t.Error("head or tail left set on an empty list")
+	}
+}
+
+func TestListInsertAt(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		index  int
+		want   []int
+	}{
+		{"empty", nil, 0, []int{9}},
+		{"front", []int{1, 2}, 0, []int{9, 1, 2}},
+		{"middle", []int{1, 2}, 1, []int{1, 9, 2}},
+		{"end", []int{1, 2}, 2, []int{1, 2, 9}},
+		{"end of one element", []int{1}, 1, []int{1, 9}},
+		{"front of one element", []int{1}, 0, []int{9, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := listOf(tt.values...)
+			if err := l.InsertAt(tt.index, 9); err != nil {
+				t.Fatalf("InsertAt(%d) = %v", tt.index, err)
+			}
+			checkValues(t, l, tt.want...)
+		})
+	}
+}
+
+func TestListInsertAtOutOfRange(t *testing.T) {
+	l := listOf(1, 2)
+	for _, index := range []int{-1, 3, 10} {
+		if err := l.InsertAt(index, 9); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("InsertAt(%d) = %v, want ErrIndexOutOfRange", index, err)
+		}
+	}
+	checkValues(t, l, 1, 2)
+}
+
+func TestListSearch(t *testing.T) {
+	l := listOf(4, 5, 6, 5)
+	for _, tt := range []struct{ value, index int }{{4, 0}, {5, 1}, {6, 2}, {7, -1}} {
+		if got := l.IndexOf(tt.value); got != tt.index {
+			t.Errorf("IndexOf(%d) = %d, want %d", tt.value, got, tt.index)
+		}
+		if got := l.Contains(tt.value); got != (tt.index >= 0) {
+			t.Errorf("Contains(%d) = %t", tt.value, got)
+		}
+	}
+	if (&List[int]{}).Contains(0) {
+		t.Error("Contains(0) on an empty list = true")
+	}
+}
+
+func TestListReverse(t *testing.T) {
+	for _, values := range [][]int{nil, {1}, {1, 2}, {1, 2, 3, 4}} {
+		l := listOf(values...)
+		l.Reverse()
+		want := slices.Clone(values)
+		slices.Reverse(want)
+		checkValues(t, l, want...)
+	}
+}
+
+func TestListFilterAndMap(t *testing.T) {
+	l := listOf(1, 2, 3, 4, 5)
+	evens := l.Filter(func(v int) bool { return v%2 == 0 })
+	checkValues(t, evens, 2, 4)
+	checkValues(t, l.Filter(func(int) bool { return false }))
+	checkValues(t, l, 1, 2, 3, 4, 5)
+
+	squares := MapList(l, func(v int) int { return v * v })
+	checkValues(t, squares, 1, 4, 9, 16, 25)
+	labels := MapList(l, func(v int) string { return string(rune('a' + v - 1)) })
+	checkList(t, labels)
+	if got := labels.GetAll(); !slices.Equal(got, []string{"a", "b", "c", "d", "e"}) {
+		t.Errorf("MapList() = %v", got)
+	}
+}
@@ -1,47 +1,131 @@
 package generics
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
 
-type genericList[T comparable] struct {
+// SliceList is a generic list backed by a slice. Index based operations report an
+// out of range index with an *IndexError matching ErrIndexOutOfRange instead of panicking.
+type SliceList[T comparable] struct {
 	data []T
 }
 
-func New[T comparable]() *genericList[T] {
-	return &genericList[T]{
+func New[T comparable]() *SliceList[T] {
+	return &SliceList[T]{
 		data: make([]T, 0),
 	}
 }
 
-func (l *genericList[T]) Insert(value T) {
+func (l *SliceList[T]) Insert(value T) {
 	l.data = append(l.data, value)
 }
 
-func (l *genericList[T]) Get(index int) (V T) {
-	if index > len(l.data)-1 {
-		panic("input index out of range")
+// checkIndex returns an error if index does not address an element of the list.
+func (l *SliceList[T]) checkIndex(index int) error {
+	if index < 0 || index >= len(l.data) {
+		return &IndexError{Index: index, Len: len(l.data)}
 	}
+	return nil
+}
+
+// Get returns the element at the given index.
+func (l *SliceList[T]) Get(index int) (T, error) {
+	if err := l.checkIndex(index); err != nil {
+		var zeroValue T
+		return zeroValue, err
+	}
+	return l.data[index], nil
+}
 
-	if index < 0 {
-		panic(fmt.Sprintf("invalid index: %d", index))
+// Set replaces the element at the given index and returns the previous one.
+func (l *SliceList[T]) Set(index int, value T) (T, error) {
+	if err := l.checkIndex(index); err != nil {
+		var zeroValue T
+		return zeroValue, err
 	}
-	return l.data[index]
+	previous := l.data[index]
+	l.data[index] = value
+	return previous, nil
 }
 
-func (l *genericList[T]) Remove(index int) (V T) {
-	if index > len(l.data)-1 {
-		panic("input index out of range")
+// InsertAt inserts value at the given index, shifting the following elements back.
+// Any index from 0 to Len is valid, Len appends to the list.
+func (l *SliceList[T]) InsertAt(index int, value T) error {
+	if index != len(l.data) {
+		if err := l.checkIndex(index); err != nil {
+			return err
+		}
 	}
+	l.data = slices.Insert(l.data, index, value)
+	return nil
+}
 
-	if index < 0 {
-		panic(fmt.Sprintf("invalid index: %d", index))
+// Remove removes and returns the element at the given index.
+func (l *SliceList[T]) Remove(index int) (T, error) {
+	if err := l.checkIndex(index); err != nil {
+		var zeroValue T
+		return zeroValue, err
 	}
 
 	removedData := l.data[index]
-	l.data = append(l.data[:index], l.data[index+1:]...)
-	return removedData
+	l.data = slices.Delete(l.data, index, index+1)
+	return removedData, nil
+}
+
+// Len returns the number of elements in the list.
+func (l *SliceList[T]) Len() int {
+	return len(l.data)
+}
+
+// IndexOf returns the index of the first occurrence of value, or -1 if it is not in the list.
+func (l *SliceList[T]) IndexOf(value T) int {
+	return slices.Index(l.data, value)
+}
+
+// Sort sorts the list in place, cmp returns a negative number when a < b, zero when
+// a == b and a positive number when a > b.
+func (l *SliceList[T]) Sort(cmp func(a, b T) int) {
+	slices.SortFunc(l.data, cmp)
+}
+
+// BinarySearch searches target in a list sorted by cmp and returns the index where it is
+// found or would be inserted, and whether it was found.
+func (l *SliceList[T]) BinarySearch(target T, cmp func(a, b T) int) (int, bool) {
+	return slices.BinarySearchFunc(l.data, target, cmp)
+}
+
+// Clone returns a copy of the list which does not share its backing slice.
+func (l *SliceList[T]) Clone() *SliceList[T] {
+	return &SliceList[T]{data: slices.Clone(l.data)}
+}
+
+// All returns an iterator over the elements of the list in index order.
+func (l *SliceList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range l.data {
+			if !yield(v) {
+				return
+			}
+		}
+	}
 }
 
-func (l *genericList[T]) PrintList() {
+// Backward returns an iterator over the elements of the list in reverse index order.
+func (l *SliceList[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := len(l.data) - 1; i >= 0; i-- {
+			if !yield(l.data[i]) {
+				return
+			}
+		}
+	}
+}
+
+func (l *SliceList[T]) PrintList() {
 	fmt.Println(l.data)
 }
 
@@ -81,8 +165,18 @@ func GenerateList() {
 	strList.Insert("sam")
 	strList.Insert("larry")
 	strList.Insert("kim")
-	println(strList.Get(1))
-	println(strList.Remove(2))
+	fmt.Println(strList.Get(1))
+	fmt.Println(strList.Remove(2))
 	strList.PrintList()
+	if _, err := strList.Get(7); errors.Is(err, ErrIndexOutOfRange) {
+		fmt.Println(err)
+	}
+	strList.InsertAt(0, "ann")
+	strList.Set(2, "tim")
+	strList.Sort(strings.Compare)
+	strList.PrintList()
+	fmt.Println(strList.BinarySearch("kim", strings.Compare))
+
+	fmt.Println(slices.Collect(floatList.Backward()))
 
 }
@@ -0,0 +1,99 @@
+package generics
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func sliceListOf(values ...int) *SliceList[int] {
+	l := New[int]()
+	for _, v := range values {
+		l.Insert(v)
+	}
+	return l
+}
+
+// checkIndexError verifies that err is an *IndexError for the given index and length.
+func checkIndexError(t *testing.T, op string, err error, index, length int) {
+	t.Helper()
+	if !errors.Is(err, ErrIndexOutOfRange) {
+		t.Fatalf("%s(%d) = %v, want ErrIndexOutOfRange", op, index, err)
+	}
+	var indexErr *IndexError
+	if !errors.As(err, &indexErr) {
+		t.Fatalf("%s(%d) = %v, want an *IndexError", op, index, err)
+	}
+	if indexErr.Index != index || indexErr.Len != length {
+		t.Errorf("%s(%d) reported index %d and length %d, want %d and %d",
+			op, index, indexErr.Index, indexErr.Len, index, length)
+	}
+}
+
+func TestSliceListOutOfRange(t *testing.T) {
+	values := []int{1, 2, 3}
+	n := len(values)
+	for _, index := range []int{-1, n, n + 1} {
+		l := sliceListOf(values...)
+
+		_, err := l.Get(index)
+		checkIndexError(t, "Get", err, index, n)
+		_, err = l.Set(index, 9)
+		checkIndexError(t, "Set", err, index, n)
+		_, err = l.Remove(index)
+		checkIndexError(t, "Remove", err, index, n)
+		if index != n {
+			checkIndexError(t, "InsertAt", l.InsertAt(index, 9), index, n)
+		}
+
+		if got := slices.Collect(l.All()); !slices.Equal(got, values) {
+			t.Errorf("list changed to %v by out of range operations at %d", got, index)
+		}
+	}
+}
+
+func TestSliceListInsertAtLen(t *testing.T) {
+	l := sliceListOf(1, 2, 3)
+	if err := l.InsertAt(l.Len(), 4); err != nil {
+		t.Fatalf("InsertAt(Len) = %v", err)
+	}
+	if err := l.InsertAt(0, 0); err != nil {
+		t.Fatalf("InsertAt(0) = %v", err)
+	}
+	if got := slices.Collect(l.All()); !slices.Equal(got, []int{0, 1, 2, 3, 4}) {
+		t.Errorf("list = %v, want [0 1 2 3 4]", got)
+	}
+	empty := New[int]()
+	if err := empty.InsertAt(0, 1); err != nil {
+		t.Errorf("InsertAt(0) on an empty list = %v", err)
+	}
+}
+
+func TestSliceListInRange(t *testing.T) {
+	l := sliceListOf(1, 2, 3)
+	if v, err := l.Get(1); err != nil || v != 2 {
+		t.Errorf("Get(1) = %d, %v; want 2, nil", v, err)
+	}
+	if v, err := l.Set(1, 5); err != nil || v != 2 {
+		t.Errorf("Set(1, 5) = %d, %v; want 2, nil", v, err)
+	}
+	if v, err := l.Remove(0); err != nil || v != 1 {
+		t.Errorf("Remove(0) = %d, %v; want 1, nil", v, err)
+	}
+	if got := slices.Collect(l.All()); !slices.Equal(got, []int{5, 3}) {
+		t.Errorf("list = %v, want [5 3]", got)
+	}
+	if l.IndexOf(3) != 1 || l.IndexOf(2) != -1 {
+		t.Errorf("IndexOf(3), IndexOf(2) = %d, %d; want 1, -1", l.IndexOf(3), l.IndexOf(2))
+	}
+}
+
+func TestIndexError(t *testing.T) {
+	err := error(&IndexError{Index: 5, Len: 3})
+	if want := "generics: index out of range: 5 with length 3"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+	if errors.Is(err, errors.New("generics: index out of range")) {
+		t.Error("IndexError matches an unrelated error")
+	}
+}
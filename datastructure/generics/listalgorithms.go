@@ -0,0 +1,207 @@
+package generics
+
+import (
+	"cmp"
+	"fmt"
+)
+
+/*
+	Classic linked list algorithms on the generic singly linked List.
+
+	1. HasCycle / CycleStart - Floyd's tortoise and hare on a chain of nodes
+	2. Middle                - slow and fast pointers
+	3. RemoveNthFromEnd      - two pointers n nodes apart
+	4. ReverseInGroups       - reverse every group of k nodes in place
+	5. MergeSorted           - splice two sorted lists into one
+	6. MergeKSorted          - merge k sorted lists pairwise in O(n log k)
+
+	The algorithms relink the existing nodes rather than allocating new ones.
+*/
+
+// Head returns the first node of the list, or nil if it is empty.
+func (a *List[T]) Head() *Nodes[T] {
+	return a.head
+}
+
+// Next returns the node following n, or nil at the end of the chain.
+func (n *Nodes[T]) Next() *Nodes[T] {
+	return n.next
+}
+
+// Value returns the value held by the node.
+func (n *Nodes[T]) Value() T {
+	return n.data
+}
+
+// HasCycle reports whether the chain of nodes starting at head loops back on itself.
+func HasCycle[T comparable](head *Nodes[T]) bool {
+	return CycleStart(head) != nil
+}
+
+// CycleStart returns the first node of the cycle in the chain starting at head, or nil if
+// the chain ends. Once the hare meets the tortoise inside the cycle, a pointer restarted from
+// head and the tortoise reach the cycle start after the same number of steps.
+func CycleStart[T comparable](head *Nodes[T]) *Nodes[T] {
+	slow, fast := head, head
+	for fast != nil && fast.next != nil {
+		slow = slow.next
+		fast = fast.next.next
+		if slow == fast {
+			for start := head; start != slow; start, slow = start.next, slow.next {
+			}
+			return slow
+		}
+	}
+	return nil
+}
+
+// Middle returns the middle value of the list. For an even length the second of the two
+// middle values is returned.
+func (a *List[T]) Middle() (T, bool) {
+	if a.head == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	slow, fast := a.head, a.head
+	for fast != nil && fast.next != nil {
+		slow = slow.next
+		fast = fast.next.next
+	}
+	return slow.data, true
+}
+
+// RemoveNthFromEnd removes and returns the nth value counted from the end, n = 1 being the last.
+func (a *List[T]) RemoveNthFromEnd(n int) (T, error) {
+	if n < 1 || n > a.size {
+		var zeroValue T
+		return zeroValue, fmt.Errorf("%w: %d from the end with length %d", ErrIndexOutOfRange, n, a.size)
+	}
+	// lead runs n nodes ahead, so prev stops right before the node to remove
+	lead := a.head
+	for i := 0; i < n; i++ {
+		lead = lead.next
+	}
+	var prev *Nodes[T]
+	current := a.head
+	for lead != nil {
+		prev, current, lead = current, current.next, lead.next
+	}
+	if prev == nil {
+		a.head = current.next
+	} else {
+		prev.next = current.next
+	}
+	if current == a.tail {
+		a.tail = prev
+	}
+	a.size--
+	return current.data, nil
+}
+
+// ReverseInGroups reverses the order of every group of k consecutive elements in place.
+// A trailing group shorter than k keeps its order.
+func (a *List[T]) ReverseInGroups(k int) {
+	if k < 2 {
+		return
+	}
+	dummy := &Nodes[T]{next: a.head}
+	groupPrev := dummy
+	for {
+		// make sure a full group follows
+		groupEnd := groupPrev
+		for i := 0; i < k && groupEnd != nil; i++ {
+			groupEnd = groupEnd.next
+		}
+		if groupEnd == nil {
+			break
+		}
+		groupStart, nextGroup := groupPrev.next, groupEnd.next
+		prev, current := nextGroup, groupStart
+		for current != nextGroup {
+			current.next, prev, current = prev, current, current.next
+		}
+		groupPrev.next = groupEnd
+		groupPrev = groupStart
+	}
+	a.head = dummy.next
+	for a.tail = a.head; a.tail != nil && a.tail.next != nil; a.tail = a.tail.next {
+	}
+}
+
+// MergeSorted merges two lists sorted in ascending order into a new sorted list.
+// The nodes of a and b are spliced into the result, so both are left empty.
+func MergeSorted[T cmp.Ordered](a, b *List[T]) *List[T] {
+	result := &List[T]{size: a.size + b.size}
+	dummy := &Nodes[T]{}
+	last := dummy
+	x, y := a.head, b.head
+	for x != nil && y != nil {
+		// taking from a on ties keeps the merge stable
+		if y.data < x.data {
+			last.next, y = y, y.next
+		} else {
+			last.next, x = x, x.next
+		}
+		last = last.next
+	}
+	if x != nil {
+		last.next, result.tail = x, a.tail
+	} else if y != nil {
+		last.next, result.tail = y, b.tail
+	}
+	result.head = dummy.next
+	*a, *b = List[T]{}, List[T]{}
+	return result
+}
+
+// MergeKSorted merges any number of lists sorted in ascending order into a new sorted list
+// by merging them in pairs, which takes O(n log k). The input lists are left empty.
+func MergeKSorted[T cmp.Ordered](lists ...*List[T]) *List[T] {
+	if len(lists) == 0 {
+		return &List[T]{}
+	}
+	for len(lists) > 1 {
+		merged := make([]*List[T], 0, (len(lists)+1)/2)
+		for i := 0; i+1 < len(lists); i += 2 {
+			merged = append(merged, MergeSorted(lists[i], lists[i+1]))
+		}
+		if len(lists)%2 == 1 {
+			merged = append(merged, lists[len(lists)-1])
+		}
+		lists = merged
+	}
+	return lists[0]
+}
+
+func RunListAlgorithms() {
+	lst := List[int]{}
+	for _, v := range []int{1, 2, 3, 4, 5, 6, 7, 8} {
+		lst.Push(v)
+	}
+	fmt.Println(lst.Middle())
+	lst.ReverseInGroups(3)
+	fmt.Println("reversed in groups of 3:", lst.GetAll())
+	fmt.Println(lst.RemoveNthFromEnd(2))
+	fmt.Println("list:", lst.GetAll())
+
+	a, b, c := &List[int]{}, &List[int]{}, &List[int]{}
+	for _, v := range []int{1, 4, 7} {
+		a.Push(v)
+	}
+	for _, v := range []int{2, 5, 8} {
+		b.Push(v)
+	}
+	for _, v := range []int{0, 3, 6, 9} {
+		c.Push(v)
+	}
+	fmt.Println("merged:", MergeKSorted(a, b, c).GetAll())
+
+	// Link the tail back to the second node to form a cycle.
+	cycle := &List[string]{}
+	for _, v := range []string{"a", "b", "c", "d"} {
+		cycle.Push(v)
+	}
+	fmt.Println("has cycle:", HasCycle(cycle.Head()))
+	cycle.tail.next = cycle.head.next
+	fmt.Println("has cycle:", HasCycle(cycle.Head()), "starting at:", CycleStart(cycle.Head()).Value())
+}
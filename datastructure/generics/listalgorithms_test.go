@@ -0,0 +1,184 @@
+package generics
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestListCycle(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		loopTo int // index of the node the tail links back to, -1 for no cycle
+	}{
+		{"empty", nil, -1},
+		{"single", []string{"a"}, -1},
+		{"no cycle", []string{"a", "b", "c", "d"}, -1},
+		{"self loop", []string{"a"}, 0},
+		{"back to head", []string{"a", "b", "c", "d"}, 0},
+		{"back to second", []string{"a", "b", "c", "d"}, 1},
+		{"back to tail", []string{"a", "b", "c", "d", "e"}, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := listOf(tt.values...)
+			var want *Nodes[string]
+			if tt.loopTo >= 0 {
+				want = l.head
+				for range tt.loopTo {
+					want = want.next
+				}
+				l.tail.next = want
+			}
+			if got := HasCycle(l.Head()); got != (want != nil) {
+				t.Errorf("HasCycle() = %t, want %t", got, want != nil)
+			}
+			if got := CycleStart(l.Head()); got != want {
+				t.Errorf("CycleStart() returned the wrong node")
+			}
+		})
+	}
+}
+
+func TestListMiddle(t *testing.T) {
+	tests := []struct {
+		values []int
+		want   int
+		ok     bool
+	}{
+		{nil, 0, false},
+		{[]int{1}, 1, true},
+		{[]int{1, 2}, 2, true},
+		{[]int{1, 2, 3}, 2, true},
+		{[]int{1, 2, 3, 4}, 3, true},
+		{[]int{1, 2, 3, 4, 5}, 3, true},
+	}
+	for _, tt := range tests {
+		if got, ok := listOf(tt.values...).Middle(); got != tt.want || ok != tt.ok {
+			t.Errorf("%v.Middle() = %d, %t; want %d, %t", tt.values, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestListRemoveNthFromEnd(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []int
+		n       int
+		want    int
+		wantErr bool
+		rest    []int
+	}{
+		{"tail", []int{1, 2, 3, 4}, 1, 4, false, []int{1, 2, 3}},
+		{"head", []int{1, 2, 3, 4}, 4, 1, false, []int{2, 3, 4}},
+		{"middle", []int{1, 2, 3, 4}, 2, 3, false, []int{1, 2, 4}},
+		{"only value", []int{1}, 1, 1, false, []int{}},
+		{"zero", []int{1, 2, 3}, 0, 0, true, []int{1, 2, 3}},
+		{"past the head", []int{1, 2, 3}, 4, 0, true, []int{1, 2, 3}},
+		{"empty", nil, 1, 0, true, []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := listOf(tt.values...)
+			got, err := l.RemoveNthFromEnd(tt.n)
+			if tt.wantErr {
+				if !errors.Is(err, ErrIndexOutOfRange) {
+					t.Errorf("RemoveNthFromEnd(%d) error = %v, want ErrIndexOutOfRange", tt.n, err)
+				}
+			} else if err != nil || got != tt.want {
+				t.Errorf("RemoveNthFromEnd(%d) = %d, %v; want %d, nil", tt.n, got, err, tt.want)
+			}
+			if rest := l.GetAll(); !slices.Equal(rest, tt.rest) {
+				t.Errorf("list %v, want %v", rest, tt.rest)
+			}
+			checkList(t, l)
+			// the tail must still be usable for appending
+			l.Push(99)
+			if rest := l.GetAll(); rest[len(rest)-1] != 99 {
+				t.Errorf("Push after RemoveNthFromEnd gave %v", rest)
+			}
+		})
+	}
+}
+
+func TestListReverseInGroups(t *testing.T) {
+	tests := []struct {
+		values []int
+		k      int
+		want   []int
+	}{
+		{nil, 2, []int{}},
+		{[]int{1, 2, 3, 4, 5, 6}, 2, []int{2, 1, 4, 3, 6, 5}},
+		{[]int{1, 2, 3, 4, 5, 6, 7}, 2, []int{2, 1, 4, 3, 6, 5, 7}},
+		{[]int{1, 2, 3, 4, 5, 6, 7, 8}, 3, []int{3, 2, 1, 6, 5, 4, 7, 8}},
+		{[]int{1, 2, 3, 4, 5, 6, 7}, 3, []int{3, 2, 1, 6, 5, 4, 7}},
+		{[]int{1, 2, 3}, 3, []int{3, 2, 1}},
+		{[]int{1, 2}, 3, []int{1, 2}},
+		{[]int{1, 2, 3}, 1, []int{1, 2, 3}},
+		{[]int{1, 2, 3}, 0, []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		l := listOf(tt.values...)
+		l.ReverseInGroups(tt.k)
+		if got := l.GetAll(); !slices.Equal(got, tt.want) {
+			t.Errorf("%v.ReverseInGroups(%d) = %v, want %v", tt.values, tt.k, got, tt.want)
+		}
+		checkList(t, l)
+	}
+}
+
+func TestMergeSorted(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []int
+		want []int
+	}{
+		{"both empty", nil, nil, []int{}},
+		{"first empty", nil, []int{1, 2}, []int{1, 2}},
+		{"second empty", []int{1, 2}, nil, []int{1, 2}},
+		{"interleaved", []int{1, 3, 5}, []int{2, 4, 6, 8}, []int{1, 2, 3, 4, 5, 6, 8}},
+		{"duplicates", []int{1, 2, 2}, []int{2, 3}, []int{1, 2, 2, 2, 3}},
+		{"disjoint", []int{5, 6}, []int{1, 2}, []int{1, 2, 5, 6}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := listOf(tt.a...), listOf(tt.b...)
+			merged := MergeSorted(a, b)
+			if got := merged.GetAll(); !slices.Equal(got, tt.want) {
+				t.Errorf("merged %v, want %v", got, tt.want)
+			}
+			checkList(t, merged)
+			if a.Len() != 0 || b.Len() != 0 {
+				t.Errorf("inputs left with %d and %d values, want empty", a.Len(), b.Len())
+			}
+		})
+	}
+}
+
+func TestMergeKSorted(t *testing.T) {
+	tests := []struct {
+		name  string
+		lists [][]int
+		want  []int
+	}{
+		{"none", nil, []int{}},
+		{"one", [][]int{{1, 2}}, []int{1, 2}},
+		{"all empty", [][]int{nil, nil, nil}, []int{}},
+		{"some empty", [][]int{nil, {3, 4}, nil, {1, 5}}, []int{1, 3, 4, 5}},
+		{"odd count", [][]int{{1, 4, 7}, {2, 5, 8}, {0, 3, 6, 9}}, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var lists []*List[int]
+			for _, values := range tt.lists {
+				lists = append(lists, listOf(values...))
+			}
+			merged := MergeKSorted(lists...)
+			if got := merged.GetAll(); !slices.Equal(got, tt.want) {
+				t.Errorf("merged %v, want %v", got, tt.want)
+			}
+			checkList(t, merged)
+		})
+	}
+}
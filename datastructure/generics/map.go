@@ -0,0 +1,22 @@
+package generics
+
+import "iter"
+
+// Map is the common set of operations shared by the hash table implementations of the project.
+type Map[K comparable, V any] interface {
+	// Get returns the value stored for the key and whether it was found.
+	Get(key K) (V, bool)
+	// Put inserts or replaces the value for the key, returning the previous value if any.
+	Put(key K, value V) (V, bool)
+	// Delete deletes the key and reports whether it was present.
+	Delete(key K) bool
+	// Len returns the number of stored entries.
+	Len() int
+	// All returns an iterator over the stored key-value pairs.
+	All() iter.Seq2[K, V]
+}
+
+var (
+	_ Map[string, any] = (*HashTable[string, any])(nil)
+	_ Map[string, any] = (*OpenHashTable[string, any])(nil)
+)
@@ -0,0 +1,43 @@
+package generics_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dev-crusader/data-structures-and-algorithms/datastructure/generics"
+	"github.com/dev-crusader/data-structures-and-algorithms/datastructure/generics/maptest"
+)
+
+// entries returns n keys, enough for the larger counts to make the tables grow and shrink again.
+func entries(n int) map[string]int {
+	m := make(map[string]int, n)
+	for i := range n {
+		m[fmt.Sprintf("key-%d", i)] = i
+	}
+	return m
+}
+
+func TestHashTableMap(t *testing.T) {
+	for _, n := range []int{2, 10, 1000} {
+		if err := maptest.TestMap(generics.NewHashTable[string, int](1), entries(n)); err != nil {
+			t.Errorf("%d entries: %v", n, err)
+		}
+	}
+	// every key colliding into a single bucket
+	collide := generics.NewHashTableWithHasher[string, int](8, func(string) uint64 { return 0 })
+	if err := maptest.TestMap(collide, entries(100)); err != nil {
+		t.Errorf("colliding hasher: %v", err)
+	}
+}
+
+func TestOpenHashTableMap(t *testing.T) {
+	for _, n := range []int{2, 10, 1000} {
+		if err := maptest.TestMap(generics.NewOpenHashTable[string, int](1), entries(n)); err != nil {
+			t.Errorf("%d entries: %v", n, err)
+		}
+	}
+	collide := generics.NewOpenHashTableWithHasher[string, int](8, func(string) uint64 { return 0 })
+	if err := maptest.TestMap(collide, entries(100)); err != nil {
+		t.Errorf("colliding hasher: %v", err)
+	}
+}
@@ -0,0 +1,110 @@
+// Package maptest implements a conformance check for implementations of generics.Map,
+// in the spirit of testing/fstest. Any map implementation can be run against it, e.g.
+//
+//	if err := maptest.TestMap(generics.NewHashTable[string, int](8), entries); err != nil {
+//		t.Fatal(err)
+//	}
+package maptest
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dev-crusader/data-structures-and-algorithms/datastructure/generics"
+)
+
+// TestMap exercises an empty map m with the given entries and reports any behaviour that
+// deviates from the generics.Map contract. The map is left empty when the check succeeds.
+// At least two entries with distinct values are needed to check that Put replaces values.
+func TestMap[K comparable, V comparable](m generics.Map[K, V], entries map[K]V) error {
+	if len(entries) < 2 {
+		return errors.New("maptest: at least two entries are required")
+	}
+	if n := m.Len(); n != 0 {
+		return fmt.Errorf("maptest: expected an empty map, Len() = %d", n)
+	}
+
+	keys := make([]K, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+
+	// Put on absent keys inserts them.
+	for i, key := range keys {
+		if previous, ok := m.Put(key, entries[key]); ok {
+			return fmt.Errorf("maptest: Put(%v) on absent key returned previous value %v", key, previous)
+		}
+		if n := m.Len(); n != i+1 {
+			return fmt.Errorf("maptest: Len() = %d after %d inserts", n, i+1)
+		}
+	}
+	if err := checkContents(m, entries); err != nil {
+		return err
+	}
+
+	// Put on present keys replaces the values, here with the value of the next key.
+	replaced := make(map[K]V, len(entries))
+	for i, key := range keys {
+		value := entries[keys[(i+1)%len(keys)]]
+		previous, ok := m.Put(key, value)
+		if !ok || previous != entries[key] {
+			return fmt.Errorf("maptest: Put(%v) on present key = %v, %t; want %v, true", key, previous, ok, entries[key])
+		}
+		replaced[key] = value
+	}
+	if n := m.Len(); n != len(entries) {
+		return fmt.Errorf("maptest: Len() = %d after replacing values, want %d", n, len(entries))
+	}
+	if err := checkContents(m, replaced); err != nil {
+		return err
+	}
+
+	// Delete removes every key exactly once.
+	for i, key := range keys {
+		if !m.Delete(key) {
+			return fmt.Errorf("maptest: Delete(%v) on present key returned false", key)
+		}
+		if m.Delete(key) {
+			return fmt.Errorf("maptest: Delete(%v) on deleted key returned true", key)
+		}
+		if value, ok := m.Get(key); ok {
+			return fmt.Errorf("maptest: Get(%v) after Delete returned %v", key, value)
+		}
+		if n := m.Len(); n != len(keys)-i-1 {
+			return fmt.Errorf("maptest: Len() = %d after %d deletes", n, i+1)
+		}
+	}
+	for key, value := range m.All() {
+		return fmt.Errorf("maptest: All() yielded %v: %v from an empty map", key, value)
+	}
+	return nil
+}
+
+// checkContents verifies that Get and All agree with the expected entries.
+func checkContents[K comparable, V comparable](m generics.Map[K, V], want map[K]V) error {
+	for key, value := range want {
+		got, ok := m.Get(key)
+		if !ok || got != value {
+			return fmt.Errorf("maptest: Get(%v) = %v, %t; want %v, true", key, got, ok, value)
+		}
+	}
+
+	seen := make(map[K]bool, len(want))
+	for key, value := range m.All() {
+		expected, ok := want[key]
+		if !ok {
+			return fmt.Errorf("maptest: All() yielded unexpected key %v", key)
+		}
+		if seen[key] {
+			return fmt.Errorf("maptest: All() yielded key %v twice", key)
+		}
+		if value != expected {
+			return fmt.Errorf("maptest: All() yielded %v: %v, want %v", key, value, expected)
+		}
+		seen[key] = true
+	}
+	if len(seen) != len(want) {
+		return fmt.Errorf("maptest: All() yielded %d entries, want %d", len(seen), len(want))
+	}
+	return nil
+}
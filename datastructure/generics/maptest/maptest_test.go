@@ -0,0 +1,21 @@
+package maptest
+
+import (
+	"testing"
+
+	"github.com/dev-crusader/data-structures-and-algorithms/datastructure/generics"
+)
+
+func TestMapRejectsTooFewEntries(t *testing.T) {
+	if err := TestMap(generics.NewHashTable[string, int](1), map[string]int{"a": 1}); err == nil {
+		t.Error("TestMap accepted a single entry")
+	}
+}
+
+func TestMapRejectsNonEmptyMap(t *testing.T) {
+	m := generics.NewHashTable[string, int](1)
+	m.Put("c", 3)
+	if err := TestMap(m, map[string]int{"a": 1, "b": 2}); err == nil {
+		t.Error("TestMap accepted a map holding entries")
+	}
+}
@@ -0,0 +1,85 @@
+package generics
+
+import (
+	"cmp"
+	"fmt"
+)
+
+/*
+	MinStack is a stack which also answers the minimum and maximum of its elements in O(1).
+	Every entry records the minimum and maximum of the stack at the time it was pushed, so
+	popping an entry restores the previous extremes without any search.
+*/
+
+type minMaxEntry[T cmp.Ordered] struct {
+	value, min, max T
+}
+
+// MinStack is a Stack of ordered values tracking its minimum and maximum.
+// The zero value is an empty stack ready to use.
+type MinStack[T cmp.Ordered] struct {
+	entries Stack[minMaxEntry[T]]
+}
+
+// Push adds v on top of the stack.
+func (m *MinStack[T]) Push(v T) {
+	entry := minMaxEntry[T]{value: v, min: v, max: v}
+	if top, ok := m.entries.Peek(); ok {
+		entry.min = min(top.min, v)
+		entry.max = max(top.max, v)
+	}
+	m.entries.Push(entry)
+}
+
+// Pop removes and returns the top element of the stack.
+func (m *MinStack[T]) Pop() (T, bool) {
+	top, ok := m.entries.Pop()
+	return top.value, ok
+}
+
+// Peek returns the top element of the stack without removing it.
+func (m *MinStack[T]) Peek() (T, bool) {
+	top, ok := m.entries.Peek()
+	return top.value, ok
+}
+
+// Min returns the smallest element of the stack.
+func (m *MinStack[T]) Min() (T, bool) {
+	top, ok := m.entries.Peek()
+	return top.min, ok
+}
+
+// Max returns the greatest element of the stack.
+func (m *MinStack[T]) Max() (T, bool) {
+	top, ok := m.entries.Peek()
+	return top.max, ok
+}
+
+func (m *MinStack[T]) Size() int {
+	return m.entries.Size()
+}
+
+// IsEmpty reports whether the stack has no elements.
+func (m *MinStack[T]) IsEmpty() bool {
+	return m.entries.IsEmpty()
+}
+
+// Clear removes all elements from the stack.
+func (m *MinStack[T]) Clear() {
+	m.entries.Clear()
+}
+
+func RunMinStack() {
+	s := MinStack[int]{}
+	for _, v := range []int{5, 3, 8, 1, 9} {
+		s.Push(v)
+	}
+	fmt.Println(s.Min()) // Output: 1, true
+	fmt.Println(s.Max()) // Output: 9, true
+
+	s.Pop()
+	s.Pop()
+	fmt.Println(s.Min())  // Output: 3, true
+	fmt.Println(s.Max())  // Output: 8, true
+	fmt.Println(s.Peek()) // Output: 8, true
+}
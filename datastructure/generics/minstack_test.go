@@ -0,0 +1,52 @@
+package generics
+
+import "testing"
+
+func TestMinStack(t *testing.T) {
+	var s MinStack[int]
+	if _, ok := s.Min(); ok {
+		t.Error("Min() on an empty stack = true")
+	}
+	if _, ok := s.Max(); ok {
+		t.Error("Max() on an empty stack = true")
+	}
+
+	// every step pushes or pops (push false) and checks the extremes afterwards
+	steps := []struct {
+		push             bool
+		value            int
+		wantMin, wantMax int
+	}{
+		{true, 5, 5, 5},
+		{true, 2, 2, 5},
+		{true, 2, 2, 5},
+		{true, 7, 2, 7},
+		{true, 1, 1, 7},
+		{true, 1, 1, 7},
+		{false, 1, 1, 7},
+		{false, 1, 2, 7},
+		{false, 7, 2, 5},
+		{false, 2, 2, 5},
+		{false, 2, 5, 5},
+	}
+	for i, step := range steps {
+		if step.push {
+			s.Push(step.value)
+		} else if v, ok := s.Pop(); !ok || v != step.value {
+			t.Fatalf("step %d: Pop() = %d, %t; want %d, true", i, v, ok, step.value)
+		}
+		if v, ok := s.Min(); !ok || v != step.wantMin {
+			t.Errorf("step %d: Min() = %d, %t; want %d, true", i, v, ok, step.wantMin)
+		}
+		if v, ok := s.Max(); !ok || v != step.wantMax {
+			t.Errorf("step %d: Max() = %d, %t; want %d, true", i, v, ok, step.wantMax)
+		}
+	}
+	if v, ok := s.Peek(); !ok || v != 5 || s.Size() != 1 {
+		t.Errorf("Peek(), Size() = %d, %t, %d; want 5, true, 1", v, ok, s.Size())
+	}
+	s.Clear()
+	if !s.IsEmpty() {
+		t.Error("IsEmpty() = false after Clear")
+	}
+}
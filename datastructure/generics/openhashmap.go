@@ -0,0 +1,276 @@
+package generics
+
+import (
+	"fmt"
+	"iter"
+)
+
+/*
+	OpenHashTable is an open-addressing alternative to the chained HashTable. Instead of a linked list
+	per bucket, all entries live in a single slot array and collisions are resolved by Robin Hood
+	linear probing: an entry being inserted takes over the slot of any entry that is closer to its home
+	slot, which keeps probe sequences short and uniform. Deletion uses backward shifting, i.e. the
+	following entries of the cluster are moved one slot back, so no tombstones are ever left behind.
+
+	No node is allocated per entry, which makes the table friendlier to the CPU cache. It exposes the
+	same method set as HashTable so the two can be swapped and benchmarked against each other.
+*/
+
+// DefaultOpenMaxLoadFactor is the load factor above which the OpenHashTable grows.
+const DefaultOpenMaxLoadFactor = 0.85
+
+type openSlot[K comparable, V any] struct {
+	key   K
+	value V
+	hash  uint64
+	dist  int // distance from the home slot
+	used  bool
+}
+
+// OpenHashTable represents a Robin Hood open-addressing hash table.
+type OpenHashTable[K comparable, V any] struct {
+	slots  []openSlot[K, V]
+	mask   uint64 // len(slots)-1, the slot count is always a power of two
+	count  int
+	hasher Hasher[K]
+}
+
+// NewOpenHashTable creates a new OpenHashTable able to hold at least size slots.
+func NewOpenHashTable[K comparable, V any](size int) *OpenHashTable[K, V] {
+	return NewOpenHashTableWithHasher[K, V](size, NewDefaultHasher[K]())
+}
+
+// NewOpenHashTableWithHasher creates a new OpenHashTable able to hold at least size slots
+// which places keys using the supplied hash function.
+func NewOpenHashTableWithHasher[K comparable, V any](size int, hasher Hasher[K]) *OpenHashTable[K, V] {
+	if hasher == nil {
+		hasher = NewDefaultHasher[K]()
+	}
+	capacity := 1
+	for capacity < size {
+		capacity <<= 1
+	}
+	return &OpenHashTable[K, V]{
+		slots:  make([]openSlot[K, V], capacity),
+		mask:   uint64(capacity - 1),
+		hasher: hasher,
+	}
+}
+
+// find returns the slot index holding the key, or -1 if the key is not in the table.
+func (ot *OpenHashTable[K, V]) find(key K) int {
+	hash := ot.hasher(key)
+	index := hash & ot.mask
+	for dist := 0; ; dist++ {
+		slot := &ot.slots[index]
+		// a richer slot means the key would have been placed before it
+		if !slot.used || slot.dist < dist {
+			return -1
+		}
+		if slot.hash == hash && slot.key == key {
+			return int(index)
+		}
+		index = (index + 1) & ot.mask
+	}
+}
+
+// place stores an entry known to be absent, swapping it with richer entries along the way.
+func (ot *OpenHashTable[K, V]) place(entry openSlot[K, V]) {
+	entry.used = true
+	entry.dist = 0
+	index := entry.hash & ot.mask
+	for {
+		slot := &ot.slots[index]
+		if !slot.used {
+			*slot = entry
+			return
+		}
+		if slot.dist < entry.dist {
+			*slot, entry = entry, *slot
+		}
+		index = (index + 1) & ot.mask
+		entry.dist++
+	}
+}
+
+// Insert inserts a new key-value pair into the table and reports whether it was added.
+// An existing value is never overwritten, use Put for that.
+func (ot *OpenHashTable[K, V]) Insert(key K, value V) bool {
+	if ot.find(key) >= 0 {
+		return false
+	}
+	if float64(ot.count+1) > DefaultOpenMaxLoadFactor*float64(len(ot.slots)) {
+		ot.resize(len(ot.slots) * 2)
+	}
+	ot.place(openSlot[K, V]{key: key, value: value, hash: ot.hasher(key)})
+	ot.count++
+	return true
+}
+
+// Retrieve retrieves a value by key from the table and returns it if found.
+func (ot *OpenHashTable[K, V]) Retrieve(key K) (V, bool) {
+	if index := ot.find(key); index >= 0 {
+		return ot.slots[index].value, true
+	}
+	var zeroValue V
+	return zeroValue, false
+}
+
+// Get is an alias of Retrieve which satisfies the Map interface.
+func (ot *OpenHashTable[K, V]) Get(key K) (V, bool) {
+	return ot.Retrieve(key)
+}
+
+// Delete deletes a value by key from the table if present.
+func (ot *OpenHashTable[K, V]) Delete(key K) bool {
+	index := ot.find(key)
+	if index < 0 {
+		return false
+	}
+	// shift the rest of the cluster back until an empty slot or an entry in its home slot
+	current := uint64(index)
+	for {
+		next := (current + 1) & ot.mask
+		if !ot.slots[next].used || ot.slots[next].dist == 0 {
+			ot.slots[current] = openSlot[K, V]{}
+			break
+		}
+		ot.slots[current] = ot.slots[next]
+		ot.slots[current].dist--
+		current = next
+	}
+	ot.count--
+	return true
+}
+
+// Put inserts the key-value pair, replacing the value if the key already exists.
+// It returns the previous value and whether the key was present.
+func (ot *OpenHashTable[K, V]) Put(key K, value V) (V, bool) {
+	if index := ot.find(key); index >= 0 {
+		previous := ot.slots[index].value
+		ot.slots[index].value = value
+		return previous, true
+	}
+	ot.Insert(key, value)
+	var zeroValue V
+	return zeroValue, false
+}
+
+// Update stores the value returned by fn for the key and returns it. fn receives the
+// current value and whether the key was present, so it can also be used to insert.
+func (ot *OpenHashTable[K, V]) Update(key K, fn func(old V, ok bool) V) V {
+	if index := ot.find(key); index >= 0 {
+		ot.slots[index].value = fn(ot.slots[index].value, true)
+		return ot.slots[index].value
+	}
+	var zeroValue V
+	value := fn(zeroValue, false)
+	ot.Insert(key, value)
+	return value
+}
+
+// GetOrInsert returns the value stored for the key if present. Otherwise it inserts the
+// value built by factory and returns it. The boolean reports whether the value was loaded.
+func (ot *OpenHashTable[K, V]) GetOrInsert(key K, factory func() V) (V, bool) {
+	if index := ot.find(key); index >= 0 {
+		return ot.slots[index].value, true
+	}
+	value := factory()
+	ot.Insert(key, value)
+	return value, false
+}
+
+// CompareAndSwap replaces the value stored for the key with new if the stored value equals old,
+// and reports whether the swap happened. Like sync.Map, it panics if the values are not comparable.
+func (ot *OpenHashTable[K, V]) CompareAndSwap(key K, old, new V) bool {
+	index := ot.find(key)
+	if index < 0 || any(ot.slots[index].value) != any(old) {
+		return false
+	}
+	ot.slots[index].value = new
+	return true
+}
+
+// Len returns the number of entries stored in the table.
+func (ot *OpenHashTable[K, V]) Len() int {
+	return ot.count
+}
+
+// Cap returns the current number of slots of the table.
+func (ot *OpenHashTable[K, V]) Cap() int {
+	return len(ot.slots)
+}
+
+// LoadFactor returns the fraction of occupied slots.
+func (ot *OpenHashTable[K, V]) LoadFactor() float64 {
+	return float64(ot.count) / float64(len(ot.slots))
+}
+
+// All returns an iterator over the key-value pairs of the table.
+// The table must not be modified while iterating.
+func (ot *OpenHashTable[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for i := range ot.slots {
+			if ot.slots[i].used && !yield(ot.slots[i].key, ot.slots[i].value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over the keys of the table.
+func (ot *OpenHashTable[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range ot.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values of the table.
+func (ot *OpenHashTable[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range ot.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// resize places every entry into a new slot array with the given number of slots.
+// The stored hashes are reused, so keys are not hashed again.
+func (ot *OpenHashTable[K, V]) resize(size int) {
+	old := ot.slots
+	ot.slots = make([]openSlot[K, V], size)
+	ot.mask = uint64(size - 1)
+	for _, slot := range old {
+		if slot.used {
+			ot.place(slot)
+		}
+	}
+}
+
+// OpenMap function to demonstrate the open-addressing hash table.
+func OpenMap() {
+	table := NewOpenHashTable[string, int](4)
+	for i, word := range []string{"alpha", "beta", "gamma", "delta", "epsilon", "zeta", "eta", "theta"} {
+		table.Insert(word, i)
+	}
+	fmt.Printf("Len: %d, Cap: %d, LoadFactor: %.2f\n", table.Len(), table.Cap(), table.LoadFactor())
+
+	if value, found := table.Retrieve("gamma"); found {
+		fmt.Println("Value for key 'gamma':", value)
+	}
+	fmt.Printf("Deleted: %t\n", table.Delete("beta"))
+	fmt.Printf("Deleted: %t\n", table.Delete("omega"))
+
+	previous, replaced := table.Put("delta", 40)
+	fmt.Printf("Put delta: previous %d, replaced %t\n", previous, replaced)
+
+	for key, value := range table.All() {
+		fmt.Printf("%s: %d\n", key, value)
+	}
+}
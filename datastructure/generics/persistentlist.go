@@ -0,0 +1,122 @@
+package generics
+
+import (
+	"fmt"
+	"iter"
+)
+
+/*
+	PersistentList is an immutable singly linked list. Every update returns a new version of the
+	list while the old version stays valid and unchanged, which makes it suitable for undo/redo.
+
+	Versions share structure: Push and Pop are O(1) as the new version simply starts one node
+	earlier or later on the same chain, and Set(i) only copies the first i nodes and shares the rest.
+*/
+
+type persistentNode[T any] struct {
+	value T
+	next  *persistentNode[T]
+}
+
+// PersistentList is an immutable singly linked list. A nil *PersistentList is not valid,
+// use NewPersistentList to create the first version.
+type PersistentList[T any] struct {
+	head *persistentNode[T]
+	size int
+}
+
+// NewPersistentList creates a list holding the given values, the first value at the front.
+func NewPersistentList[T any](values ...T) *PersistentList[T] {
+	list := &PersistentList[T]{}
+	for i := len(values) - 1; i >= 0; i-- {
+		list = list.Push(values[i])
+	}
+	return list
+}
+
+// Push returns a new version of the list with v added to the front.
+func (l *PersistentList[T]) Push(v T) *PersistentList[T] {
+	return &PersistentList[T]{head: &persistentNode[T]{value: v, next: l.head}, size: l.size + 1}
+}
+
+// Pop returns a new version of the list without its front value, together with that value.
+func (l *PersistentList[T]) Pop() (*PersistentList[T], T, bool) {
+	if l.head == nil {
+		var zeroValue T
+		return l, zeroValue, false
+	}
+	return &PersistentList[T]{head: l.head.next, size: l.size - 1}, l.head.value, true
+}
+
+// Peek returns the front value of the list.
+func (l *PersistentList[T]) Peek() (T, bool) {
+	if l.head == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return l.head.value, true
+}
+
+// Get returns the value at the given index.
+func (l *PersistentList[T]) Get(index int) (T, error) {
+	if index < 0 || index >= l.size {
+		var zeroValue T
+		return zeroValue, &IndexError{Index: index, Len: l.size}
+	}
+	node := l.head
+	for ; index > 0; index-- {
+		node = node.next
+	}
+	return node.value, nil
+}
+
+// Set returns a new version of the list with the value at the given index replaced.
+// The nodes before the index are copied, the ones after it are shared.
+func (l *PersistentList[T]) Set(index int, v T) (*PersistentList[T], error) {
+	if index < 0 || index >= l.size {
+		return l, &IndexError{Index: index, Len: l.size}
+	}
+	prefix := make([]T, 0, index)
+	node := l.head
+	for ; index > 0; index-- {
+		prefix = append(prefix, node.value)
+		node = node.next
+	}
+	head := &persistentNode[T]{value: v, next: node.next}
+	for i := len(prefix) - 1; i >= 0; i-- {
+		head = &persistentNode[T]{value: prefix[i], next: head}
+	}
+	return &PersistentList[T]{head: head, size: l.size}, nil
+}
+
+// Len returns the number of values in the list.
+func (l *PersistentList[T]) Len() int {
+	return l.size
+}
+
+// All returns an iterator over the values of the list from front to back.
+func (l *PersistentList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := l.head; node != nil; node = node.next {
+			if !yield(node.value) {
+				return
+			}
+		}
+	}
+}
+
+func RunPersistentList() {
+	v1 := NewPersistentList("b", "c")
+	v2 := v1.Push("a")
+	v3, _ := v2.Set(1, "B")
+	v4, popped, _ := v3.Pop()
+
+	for i, version := range []*PersistentList[string]{v1, v2, v3, v4} {
+		var values []string
+		for v := range version.All() {
+			values = append(values, v)
+		}
+		fmt.Printf("v%d: %v\n", i+1, values)
+	}
+	fmt.Println("popped:", popped)
+}
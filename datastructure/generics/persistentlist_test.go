@@ -0,0 +1,70 @@
+package generics
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func checkPersistentList(t *testing.T, l *PersistentList[int], want []int) {
+	t.Helper()
+	if l.Len() != len(want) {
+		t.Fatalf("Len() = %d, want %d", l.Len(), len(want))
+	}
+	if got := slices.Collect(l.All()); !slices.Equal(got, want) {
+		t.Fatalf("All() = %v, want %v", got, want)
+	}
+}
+
+func TestPersistentListKeepsVersions(t *testing.T) {
+	empty := NewPersistentList[int]()
+	one := empty.Push(1)
+	two := one.Push(2)
+	three := two.Push(3)
+	set, err := three.Set(1, 20)
+	if err != nil {
+		t.Fatalf("Set(1) = %v", err)
+	}
+	setLast, err := three.Set(2, 10)
+	if err != nil {
+		t.Fatalf("Set(2) = %v", err)
+	}
+	popped, front, ok := set.Pop()
+	if !ok || front != 3 {
+		t.Fatalf("Pop() = %d, %t; want 3, true", front, ok)
+	}
+	branch := popped.Push(4)
+
+	checkPersistentList(t, empty, nil)
+	checkPersistentList(t, one, []int{1})
+	checkPersistentList(t, two, []int{2, 1})
+	checkPersistentList(t, three, []int{3, 2, 1})
+	checkPersistentList(t, set, []int{3, 20, 1})
+	checkPersistentList(t, setLast, []int{3, 2, 10})
+	checkPersistentList(t, popped, []int{20, 1})
+	checkPersistentList(t, branch, []int{4, 20, 1})
+
+	if _, _, ok := empty.Pop(); ok {
+		t.Error("Pop() on an empty list = true")
+	}
+	if v, ok := branch.Peek(); !ok || v != 4 {
+		t.Errorf("Peek() = %d, %t; want 4, true", v, ok)
+	}
+}
+
+func TestPersistentListOutOfRange(t *testing.T) {
+	l := NewPersistentList(1, 2, 3)
+	for _, index := range []int{-1, 3, 4} {
+		if _, err := l.Get(index); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("Get(%d) = %v, want ErrIndexOutOfRange", index, err)
+		}
+		if next, err := l.Set(index, 0); !errors.Is(err, ErrIndexOutOfRange) || next != l {
+			t.Errorf("Set(%d) = %v, want ErrIndexOutOfRange and the same list", index, err)
+		}
+	}
+	for i, want := range []int{1, 2, 3} {
+		if v, err := l.Get(i); err != nil || v != want {
+			t.Errorf("Get(%d) = %d, %v; want %d, nil", i, v, err, want)
+		}
+	}
+}
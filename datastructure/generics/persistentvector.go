@@ -0,0 +1,361 @@
+package generics
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+)
+
+/*
+	PersistentVector is an immutable indexed sequence in the style of Clojure's vector: a 32-way
+	trie whose leaves hold the values, plus a separate tail holding the last (up to 32) values.
+
+	Push, Set and Pop return a new version of the vector which shares all untouched nodes with the
+	old one, only the O(log32 n) nodes on the path to the changed index are copied. Pushes mostly
+	only copy the small tail.
+
+	For bulk builds, Transient returns a mutable view of a vector. Nodes created by a transient are
+	tagged with its owner token and are updated in place, so building a vector with n values costs
+	about n writes instead of n path copies. Persistent turns the transient back into a vector and
+	retires the token, after which the transient must not be used anymore.
+*/
+
+const (
+	vectorBits  = 5
+	vectorWidth = 1 << vectorBits
+	vectorMask  = vectorWidth - 1
+)
+
+// transientOwner identifies the transient allowed to update a node in place. It is not an
+// empty struct, so every owner has a distinct address.
+type transientOwner struct {
+	_ int
+}
+
+type vectorNode[T any] struct {
+	children []*vectorNode[T] // set on internal nodes
+	values   []T              // set on leaves
+	edit     *transientOwner  // nil once the node may be shared between versions
+}
+
+// editable returns node itself if it is owned by edit, or an owned copy of it.
+func editable[T any](node *vectorNode[T], edit *transientOwner) *vectorNode[T] {
+	if edit != nil && node.edit == edit {
+		return node
+	}
+	return &vectorNode[T]{
+		children: slices.Clone(node.children),
+		values:   slices.Clone(node.values),
+		edit:     edit,
+	}
+}
+
+// newPath wraps node in a chain of single child internal nodes down from level.
+func newPath[T any](level uint, node *vectorNode[T], edit *transientOwner) *vectorNode[T] {
+	if level == 0 {
+		return node
+	}
+	return &vectorNode[T]{children: []*vectorNode[T]{newPath(level-vectorBits, node, edit)}, edit: edit}
+}
+
+// pushTail adds the full tail leaf as the last leaf of the trie of a vector of count values.
+func pushTail[T any](count int, level uint, parent, tailNode *vectorNode[T], edit *transientOwner) *vectorNode[T] {
+	ret := editable(parent, edit)
+	subidx := ((count - 1) >> level) & vectorMask
+	var insert *vectorNode[T]
+	switch {
+	case level == vectorBits:
+		insert = tailNode
+	case subidx < len(parent.children):
+		insert = pushTail(count, level-vectorBits, parent.children[subidx], tailNode, edit)
+	default:
+		insert = newPath(level-vectorBits, tailNode, edit)
+	}
+	if subidx < len(ret.children) {
+		ret.children[subidx] = insert
+	} else {
+		ret.children = append(ret.children, insert)
+	}
+	return ret
+}
+
+// assoc replaces the value at index in the trie below node.
+func assoc[T any](level uint, node *vectorNode[T], index int, v T, edit *transientOwner) *vectorNode[T] {
+	ret := editable(node, edit)
+	if level == 0 {
+		ret.values[index&vectorMask] = v
+		return ret
+	}
+	subidx := (index >> level) & vectorMask
+	ret.children[subidx] = assoc(level-vectorBits, node.children[subidx], index, v, edit)
+	return ret
+}
+
+// popTail removes the last leaf from the trie of a vector of count values. It returns nil
+// if the node becomes empty.
+func popTail[T any](count int, level uint, node *vectorNode[T], edit *transientOwner) *vectorNode[T] {
+	subidx := ((count - 2) >> level) & vectorMask
+	if level > vectorBits {
+		child := popTail(count, level-vectorBits, node.children[subidx], edit)
+		if child == nil && subidx == 0 {
+			return nil
+		}
+		ret := editable(node, edit)
+		if child == nil {
+			ret.children = ret.children[:subidx]
+		} else {
+			ret.children[subidx] = child
+		}
+		return ret
+	}
+	if subidx == 0 {
+		return nil
+	}
+	ret := editable(node, edit)
+	ret.children = ret.children[:subidx]
+	return ret
+}
+
+// vectorTrie holds the shape shared by the persistent and the transient vector.
+type vectorTrie[T any] struct {
+	count int
+	shift uint
+	root  *vectorNode[T]
+	tail  []T
+}
+
+// tailOffset returns the index of the first value held by the tail.
+func (v *vectorTrie[T]) tailOffset() int {
+	if v.count < vectorWidth {
+		return 0
+	}
+	return ((v.count - 1) >> vectorBits) << vectorBits
+}
+
+// leafFor returns the leaf values holding index, which must be in range.
+func (v *vectorTrie[T]) leafFor(index int) []T {
+	if index >= v.tailOffset() {
+		return v.tail
+	}
+	node := v.root
+	for level := v.shift; level > 0; level -= vectorBits {
+		node = node.children[(index>>level)&vectorMask]
+	}
+	return node.values
+}
+
+func (v *vectorTrie[T]) checkIndex(index int) error {
+	if index < 0 || index >= v.count {
+		return &IndexError{Index: index, Len: v.count}
+	}
+	return nil
+}
+
+func (v *vectorTrie[T]) get(index int) (T, error) {
+	if err := v.checkIndex(index); err != nil {
+		var zeroValue T
+		return zeroValue, err
+	}
+	return v.leafFor(index)[index&vectorMask], nil
+}
+
+// pushLeaf moves the full tail into the trie, growing the trie by a level when the root is full.
+func (v *vectorTrie[T]) pushLeaf(tailNode *vectorNode[T], edit *transientOwner) {
+	if (v.count >> vectorBits) > (1 << v.shift) {
+		v.root = &vectorNode[T]{children: []*vectorNode[T]{v.root, newPath(v.shift, tailNode, edit)}, edit: edit}
+		v.shift += vectorBits
+	} else {
+		v.root = pushTail(v.count, v.shift, v.root, tailNode, edit)
+	}
+}
+
+// PersistentVector is an immutable indexed sequence. A nil *PersistentVector is not valid,
+// use NewPersistentVector to create the first version.
+type PersistentVector[T any] struct {
+	vectorTrie[T]
+}
+
+// NewPersistentVector creates a vector holding the given values, built through a transient.
+func NewPersistentVector[T any](values ...T) *PersistentVector[T] {
+	empty := &PersistentVector[T]{vectorTrie[T]{shift: vectorBits, root: &vectorNode[T]{}}}
+	if len(values) == 0 {
+		return empty
+	}
+	t := empty.Transient()
+	for _, v := range values {
+		t.Push(v)
+	}
+	return t.Persistent()
+}
+
+// Get returns the value at the given index.
+func (v *PersistentVector[T]) Get(index int) (T, error) {
+	return v.get(index)
+}
+
+// Len returns the number of values in the vector.
+func (v *PersistentVector[T]) Len() int {
+	return v.count
+}
+
+// Push returns a new version of the vector with x appended.
+func (v *PersistentVector[T]) Push(x T) *PersistentVector[T] {
+	next := v.vectorTrie
+	if v.count-v.tailOffset() < vectorWidth {
+		next.tail = make([]T, len(v.tail)+1)
+		copy(next.tail, v.tail)
+		next.tail[len(v.tail)] = x
+	} else {
+		next.pushLeaf(&vectorNode[T]{values: v.tail}, nil)
+		next.tail = []T{x}
+	}
+	next.count++
+	return &PersistentVector[T]{next}
+}
+
+// Set returns a new version of the vector with the value at the given index replaced.
+func (v *PersistentVector[T]) Set(index int, x T) (*PersistentVector[T], error) {
+	if err := v.checkIndex(index); err != nil {
+		return v, err
+	}
+	next := v.vectorTrie
+	if index >= v.tailOffset() {
+		next.tail = slices.Clone(v.tail)
+		next.tail[index&vectorMask] = x
+	} else {
+		next.root = assoc(v.shift, v.root, index, x, nil)
+	}
+	return &PersistentVector[T]{next}, nil
+}
+
+// Pop returns a new version of the vector without its last value, together with that value.
+func (v *PersistentVector[T]) Pop() (*PersistentVector[T], T, bool) {
+	if v.count == 0 {
+		var zeroValue T
+		return v, zeroValue, false
+	}
+	last, _ := v.get(v.count - 1)
+	if v.count == 1 {
+		return NewPersistentVector[T](), last, true
+	}
+
+	next := v.vectorTrie
+	next.count--
+	if v.count-v.tailOffset() > 1 {
+		// the prefix of the tail can be shared, as Push always copies the tail
+		next.tail = v.tail[: len(v.tail)-1 : len(v.tail)-1]
+		return &PersistentVector[T]{next}, last, true
+	}
+
+	next.tail = v.leafFor(v.count - 2)
+	next.root = popTail(v.count, v.shift, v.root, nil)
+	if next.root == nil {
+		next.root = &vectorNode[T]{}
+	}
+	if next.shift > vectorBits && len(next.root.children) == 1 {
+		next.root = next.root.children[0]
+		next.shift -= vectorBits
+	}
+	return &PersistentVector[T]{next}, last, true
+}
+
+// All returns an iterator over the values of the vector in index order.
+func (v *PersistentVector[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for base := 0; base < v.count; base += vectorWidth {
+			for _, x := range v.leafFor(base) {
+				if !yield(x) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Transient returns a mutable copy of the vector for batching many updates.
+func (v *PersistentVector[T]) Transient() *TransientVector[T] {
+	t := &TransientVector[T]{vectorTrie: v.vectorTrie, edit: &transientOwner{}}
+	t.tail = make([]T, len(v.tail), vectorWidth)
+	copy(t.tail, v.tail)
+	return t
+}
+
+// TransientVector is a mutable view of a PersistentVector whose updates happen in place.
+// It is not safe for concurrent use and must not be used after calling Persistent.
+type TransientVector[T any] struct {
+	vectorTrie[T]
+	edit *transientOwner
+}
+
+func (t *TransientVector[T]) ensureEditable() {
+	if t.edit == nil {
+		panic("generics: transient vector used after Persistent")
+	}
+}
+
+// Push appends x to the vector.
+func (t *TransientVector[T]) Push(x T) {
+	t.ensureEditable()
+	if t.count-t.tailOffset() < vectorWidth {
+		t.tail = append(t.tail, x)
+		t.count++
+		return
+	}
+	t.pushLeaf(&vectorNode[T]{values: t.tail, edit: t.edit}, t.edit)
+	t.tail = make([]T, 1, vectorWidth)
+	t.tail[0] = x
+	t.count++
+}
+
+// Set replaces the value at the given index.
+func (t *TransientVector[T]) Set(index int, x T) error {
+	t.ensureEditable()
+	if err := t.checkIndex(index); err != nil {
+		return err
+	}
+	if index >= t.tailOffset() {
+		t.tail[index&vectorMask] = x
+	} else {
+		t.root = assoc(t.shift, t.root, index, x, t.edit)
+	}
+	return nil
+}
+
+// Get returns the value at the given index.
+func (t *TransientVector[T]) Get(index int) (T, error) {
+	t.ensureEditable()
+	return t.get(index)
+}
+
+// Len returns the number of values in the vector.
+func (t *TransientVector[T]) Len() int {
+	return t.count
+}
+
+// Persistent returns the immutable vector holding the values of the transient.
+func (t *TransientVector[T]) Persistent() *PersistentVector[T] {
+	t.ensureEditable()
+	t.edit = nil
+	return &PersistentVector[T]{t.vectorTrie}
+}
+
+func RunPersistentVector() {
+	v1 := NewPersistentVector[int]()
+	for i := 0; i < 40; i++ {
+		v1 = v1.Push(i)
+	}
+	v2, _ := v1.Set(5, 500)
+	v3, last, _ := v2.Pop()
+
+	fmt.Println(v1.Get(5))
+	fmt.Println(v2.Get(5))
+	fmt.Println("popped:", last, "lengths:", v1.Len(), v2.Len(), v3.Len())
+
+	t := v3.Transient()
+	for i := 100; i < 1100; i++ {
+		t.Push(i)
+	}
+	v4 := t.Persistent()
+	fmt.Println(v4.Len(), v3.Len())
+	fmt.Println(v4.Get(1000))
+}
@@ -0,0 +1,184 @@
+package generics
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// vectorSizes cross the boundaries at which the tail moves into the trie (32) and at which
+// the trie grows a level (32+32²).
+var vectorSizes = []int{0, 1, 31, 32, 33, 63, 64, 65, 1055, 1056, 1057, 1100}
+
+// version is a vector together with the values it must hold.
+type version struct {
+	vector *PersistentVector[int]
+	values []int
+}
+
+func checkVector(t *testing.T, v *PersistentVector[int], want []int) {
+	t.Helper()
+	if v.Len() != len(want) {
+		t.Fatalf("Len() = %d, want %d", v.Len(), len(want))
+	}
+	if got := slices.Collect(v.All()); !slices.Equal(got, want) {
+		t.Fatalf("All() = %v, want %v", got, want)
+	}
+	for i, x := range want {
+		if got, err := v.Get(i); err != nil || got != x {
+			t.Fatalf("Get(%d) = %d, %v; want %d, nil", i, got, err, x)
+		}
+	}
+}
+
+func checkVersions(t *testing.T, versions []version) {
+	t.Helper()
+	for _, version := range versions {
+		checkVector(t, version.vector, version.values)
+	}
+}
+
+func TestPersistentVectorPushKeepsVersions(t *testing.T) {
+	v := NewPersistentVector[int]()
+	versions := []version{{v, nil}}
+	var values []int
+	for _, size := range vectorSizes[1:] {
+		for len(values) < size {
+			values = append(values, len(values))
+			v = v.Push(len(values) - 1)
+		}
+		versions = append(versions, version{v, slices.Clone(values)})
+	}
+	checkVersions(t, versions)
+}
+
+func TestPersistentVectorSetKeepsVersions(t *testing.T) {
+	for _, size := range vectorSizes[1:] {
+		values := make([]int, size)
+		for i := range values {
+			values[i] = i
+		}
+		v := NewPersistentVector(values...)
+		versions := []version{{v, slices.Clone(values)}}
+		for _, index := range []int{0, size / 2, size - 1, min(31, size-1), min(32, size-1), min(1055, size-1)} {
+			next, err := v.Set(index, -index-1)
+			if err != nil {
+				t.Fatalf("Set(%d) on %d values = %v", index, size, err)
+			}
+			values[index] = -index - 1
+			v = next
+			versions = append(versions, version{v, slices.Clone(values)})
+		}
+		checkVersions(t, versions)
+	}
+}
+
+func TestPersistentVectorPopKeepsVersions(t *testing.T) {
+	size := vectorSizes[len(vectorSizes)-1]
+	values := make([]int, size)
+	for i := range values {
+		values[i] = i
+	}
+	v := NewPersistentVector(values...)
+	versions := []version{{v, slices.Clone(values)}}
+	for len(values) > 0 {
+		next, last, ok := v.Pop()
+		if !ok || last != len(values)-1 {
+			t.Fatalf("Pop() = %d, %t; want %d, true", last, ok, len(values)-1)
+		}
+		values = values[:len(values)-1]
+		v = next
+		if slices.Contains(vectorSizes, len(values)) || slices.Contains(vectorSizes, len(values)+1) {
+			versions = append(versions, version{v, slices.Clone(values)})
+		}
+	}
+	if _, _, ok := v.Pop(); ok {
+		t.Error("Pop() on an empty vector = true")
+	}
+	checkVersions(t, versions)
+
+	// pushing onto a popped version must not change the version it was popped from
+	for _, version := range versions {
+		if len(version.values) > 0 {
+			popped, _, _ := version.vector.Pop()
+			popped.Push(-1)
+		}
+	}
+	checkVersions(t, versions)
+}
+
+func TestPersistentVectorOutOfRange(t *testing.T) {
+	v := NewPersistentVector(1, 2, 3)
+	for _, index := range []int{-1, 3, 4} {
+		if _, err := v.Get(index); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("Get(%d) = %v, want ErrIndexOutOfRange", index, err)
+		}
+		if next, err := v.Set(index, 0); !errors.Is(err, ErrIndexOutOfRange) || next != v {
+			t.Errorf("Set(%d) = %v, want ErrIndexOutOfRange and the same vector", index, err)
+		}
+	}
+}
+
+func TestTransientVectorKeepsSource(t *testing.T) {
+	for _, size := range vectorSizes {
+		values := make([]int, size)
+		for i := range values {
+			values[i] = i
+		}
+		source := NewPersistentVector(values...)
+
+		tv := source.Transient()
+		for i := range size {
+			if err := tv.Set(i, -i); err != nil {
+				t.Fatalf("Set(%d) = %v", i, err)
+			}
+		}
+		for i := range 100 {
+			tv.Push(size + i)
+		}
+		batched := tv.Persistent()
+
+		checkVector(t, source, values)
+		want := make([]int, 0, size+100)
+		for i := range size {
+			want = append(want, -i)
+		}
+		for i := range 100 {
+			want = append(want, size+i)
+		}
+		checkVector(t, batched, want)
+
+		// a second transient of the same source must not see or change the first batch
+		second := source.Transient()
+		second.Push(-1)
+		if size > 0 {
+			second.Set(0, 99)
+		}
+		second.Persistent()
+		checkVector(t, source, values)
+		checkVector(t, batched, want)
+	}
+}
+
+func TestTransientVectorAfterPersistent(t *testing.T) {
+	tv := NewPersistentVector(1, 2, 3).Transient()
+	v := tv.Persistent()
+
+	uses := map[string]func(){
+		"Push":       func() { tv.Push(4) },
+		"Set":        func() { tv.Set(0, 4) },
+		"Get":        func() { tv.Get(0) },
+		"Persistent": func() { tv.Persistent() },
+	}
+	for name, use := range uses {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s after Persistent did not panic", name)
+				}
+			}()
+			use()
+		})
+	}
+	checkVector(t, v, []int{1, 2, 3})
+}
@@ -0,0 +1,154 @@
+package generics
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+/*
+	Serialization of the HashTable so it can be persisted and reloaded with identical contents.
+
+	JSON:   {"version":1,"maxLoadFactor":0.75,"minLoadFactor":0,"entries":[{"key":...,"value":...}]}
+	        Entries are stored as a list so keys of any type (not only strings) can be encoded.
+	Binary: a 4 byte header, the magic "GHT" followed by the format version, then the gob encoding
+	        of the same snapshot.
+
+	Only the contents and the load factors are persisted. The hash function is not, a decoded table
+	keeps its hasher if it was created with one and gets the default seeded hasher otherwise.
+*/
+
+// hashTableFormatVersion is the current version of the serialized form.
+const hashTableFormatVersion = 1
+
+var hashTableMagic = []byte("GHT")
+
+// ErrUnsupportedVersion is returned when decoding data written by an unknown format version.
+var ErrUnsupportedVersion = errors.New("generics: unsupported hash table format version")
+
+type hashTableEntry[K comparable, V any] struct {
+	Key   K `json:"key"`
+	Value V `json:"value"`
+}
+
+type hashTableSnapshot[K comparable, V any] struct {
+	Version       int                    `json:"version"`
+	MaxLoadFactor float64                `json:"maxLoadFactor"`
+	MinLoadFactor float64                `json:"minLoadFactor"`
+	Entries       []hashTableEntry[K, V] `json:"entries"`
+}
+
+func (ht *HashTable[K, V]) snapshot() hashTableSnapshot[K, V] {
+	entries := make([]hashTableEntry[K, V], 0, ht.count)
+	for key, value := range ht.All() {
+		entries = append(entries, hashTableEntry[K, V]{Key: key, Value: value})
+	}
+	return hashTableSnapshot[K, V]{
+		Version:       hashTableFormatVersion,
+		MaxLoadFactor: ht.maxLoadFactor,
+		MinLoadFactor: ht.minLoadFactor,
+		Entries:       entries,
+	}
+}
+
+// restore replaces the contents of the table with the snapshot. The table is left untouched
+// if the snapshot is invalid.
+func (ht *HashTable[K, V]) restore(s hashTableSnapshot[K, V]) error {
+	if s.Version != hashTableFormatVersion {
+		return fmt.Errorf("%w: %d", ErrUnsupportedVersion, s.Version)
+	}
+	hasher := ht.hasher
+	if hasher == nil {
+		hasher = NewDefaultHasher[K]()
+	}
+	restored := newHashTable[K, V](ht.minSize, s.MaxLoadFactor, s.MinLoadFactor, hasher)
+	for _, entry := range s.Entries {
+		if !restored.Insert(entry.Key, entry.Value) {
+			return fmt.Errorf("generics: duplicate key %v in serialized hash table", entry.Key)
+		}
+	}
+	*ht = *restored
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (ht *HashTable[K, V]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ht.snapshot())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, replacing the contents of the table.
+func (ht *HashTable[K, V]) UnmarshalJSON(data []byte) error {
+	var s hashTableSnapshot[K, V]
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return ht.restore(s)
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (ht *HashTable[K, V]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.Write(hashTableMagic)
+	buf.WriteByte(hashTableFormatVersion)
+	if err := gob.NewEncoder(&buf).Encode(ht.snapshot()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, replacing the contents of the table.
+func (ht *HashTable[K, V]) UnmarshalBinary(data []byte) error {
+	header := len(hashTableMagic) + 1
+	if len(data) < header || !bytes.Equal(data[:len(hashTableMagic)], hashTableMagic) {
+		return errors.New("generics: invalid hash table binary header")
+	}
+	if version := int(data[len(hashTableMagic)]); version != hashTableFormatVersion {
+		return fmt.Errorf("%w: %d", ErrUnsupportedVersion, version)
+	}
+	var s hashTableSnapshot[K, V]
+	if err := gob.NewDecoder(bytes.NewReader(data[header:])).Decode(&s); err != nil {
+		return err
+	}
+	return ht.restore(s)
+}
+
+// SerializeMap function to demonstrate persisting and reloading a hash table.
+func SerializeMap() {
+	infoHashTable := NewHashTable[string, *Student](10)
+	infoHashTable.Insert("123", NewStudent(21, "123", "Jim", "Dallas"))
+	infoHashTable.Insert("132", NewStudent(24, "132", "Railey", "Houston"))
+	infoHashTable.Insert("543", NewStudent(22, "543", "Matt", "Florida"))
+
+	data, err := json.Marshal(infoHashTable)
+	if err != nil {
+		fmt.Println("Marshal error:", err)
+		return
+	}
+	fmt.Println(string(data))
+
+	var fromJSON HashTable[string, *Student]
+	if err := json.Unmarshal(data, &fromJSON); err != nil {
+		fmt.Println("Unmarshal error:", err)
+		return
+	}
+	if value, found := fromJSON.Retrieve("132"); found {
+		fmt.Printf("Value for key '132' after JSON round trip: %v\n", value)
+	}
+
+	binary, err := infoHashTable.MarshalBinary()
+	if err != nil {
+		fmt.Println("MarshalBinary error:", err)
+		return
+	}
+	fromBinary := NewHashTable[string, *Student](1)
+	if err := fromBinary.UnmarshalBinary(binary); err != nil {
+		fmt.Println("UnmarshalBinary error:", err)
+		return
+	}
+	fmt.Printf("Binary round trip: %d bytes, %d entries\n", len(binary), fromBinary.Len())
+	if value, found := fromBinary.Retrieve("543"); found {
+		fmt.Printf("Value for key '543' after binary round trip: %v\n", value)
+	}
+}
@@ -0,0 +1,103 @@
+package generics
+
+import (
+	"encoding/json"
+	"errors"
+	"maps"
+	"testing"
+)
+
+func contents[K comparable, V any](ht *HashTable[K, V]) map[K]V {
+	return maps.Collect(ht.All())
+}
+
+func TestHashTableRoundTrip(t *testing.T) {
+	students := NewHashTableWithLoadFactor[string, Student](2, 0.5, 0.1)
+	students.Insert("123", *NewStudent(21, "123", "Jim", "Dallas"))
+	students.Insert("132", *NewStudent(24, "132", "Railey", "Houston"))
+	students.Insert("543", *NewStudent(22, "543", "Matt", "Florida"))
+
+	t.Run("json", func(t *testing.T) {
+		data, err := json.Marshal(students)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded HashTable[string, Student]
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if !maps.Equal(contents(&decoded), contents(students)) {
+			t.Errorf("decoded %v, want %v", contents(&decoded), contents(students))
+		}
+		if decoded.maxLoadFactor != 0.5 || decoded.minLoadFactor != 0.1 {
+			t.Errorf("load factors %v, %v; want 0.5, 0.1", decoded.maxLoadFactor, decoded.minLoadFactor)
+		}
+	})
+
+	t.Run("binary", func(t *testing.T) {
+		data, err := students.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded := NewHashTable[string, Student](1)
+		decoded.Insert("stale", Student{})
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if !maps.Equal(contents(decoded), contents(students)) {
+			t.Errorf("decoded %v, want %v", contents(decoded), contents(students))
+		}
+	})
+
+	t.Run("pointer values and int keys", func(t *testing.T) {
+		ht := NewHashTable[int, *Student](4)
+		for i := 0; i < 100; i++ {
+			ht.Insert(i, NewStudent(i, "", "", ""))
+		}
+		data, err := ht.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded HashTable[int, *Student]
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			t.Fatal(err)
+		}
+		if decoded.Len() != 100 {
+			t.Fatalf("Len() = %d, want 100", decoded.Len())
+		}
+		for i := 0; i < 100; i++ {
+			if s, ok := decoded.Get(i); !ok || s.Age != i {
+				t.Fatalf("Get(%d) = %v, %t", i, s, ok)
+			}
+		}
+	})
+}
+
+func TestHashTableUnmarshalInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		err  error
+	}{
+		{"duplicate key", `{"version":1,"maxLoadFactor":0.75,"entries":[{"key":"a","value":1},{"key":"a","value":2}]}`, nil},
+		{"unknown version", `{"version":2,"maxLoadFactor":0.75,"entries":[]}`, ErrUnsupportedVersion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ht := NewHashTable[string, int](4)
+			ht.Insert("keep", 7)
+			err := json.Unmarshal([]byte(tt.data), ht)
+			if err == nil || (tt.err != nil && !errors.Is(err, tt.err)) {
+				t.Fatalf("Unmarshal error = %v, want %v", err, tt.err)
+			}
+			if v, ok := ht.Get("keep"); !ok || v != 7 || ht.Len() != 1 {
+				t.Errorf("table changed by failed Unmarshal: %v", contents(ht))
+			}
+		})
+	}
+
+	ht := NewHashTable[string, int](4)
+	if err := ht.UnmarshalBinary([]byte("XYZ\x01")); err == nil {
+		t.Error("UnmarshalBinary accepted an invalid header")
+	}
+}
@@ -1,6 +1,12 @@
 package generics
 
-import "fmt"
+import (
+	"fmt"
+	"iter"
+)
+
+// stackShrinkFloor is the capacity below which a Stack never shrinks its backing slice.
+const stackShrinkFloor = 16
 
 type Stack[T any] struct {
 	data []T
@@ -18,15 +24,78 @@ func (a *Stack[T]) Pop() (T, bool) {
 
 	lastIndex := len(a.data) - 1
 	popped := a.data[lastIndex]
+	var zeroValue T
+	a.data[lastIndex] = zeroValue // release the reference for the garbage collector
 	a.data = a.data[:lastIndex]
+	a.shrink()
 
 	return popped, true
 }
 
+// shrink halves the backing slice once it is only a quarter full, so the memory of a
+// large burst of pushes is given back. Halving rather than quartering leaves room to
+// grow again without an immediate reallocation.
+func (a *Stack[T]) shrink() {
+	if c := cap(a.data); c > stackShrinkFloor && len(a.data) <= c/4 {
+		data := make([]T, len(a.data), c/2)
+		copy(data, a.data)
+		a.data = data
+	}
+}
+
+// Peek returns the top element of the stack without removing it.
+func (a *Stack[T]) Peek() (T, bool) {
+	if len(a.data) == 0 {
+		var r T
+		return r, false
+	}
+	return a.data[len(a.data)-1], true
+}
+
 func (a *Stack[T]) Size() int {
 	return len(a.data)
 }
 
+// IsEmpty reports whether the stack has no elements.
+func (a *Stack[T]) IsEmpty() bool {
+	return len(a.data) == 0
+}
+
+// Clear removes all elements and releases the backing slice.
+func (a *Stack[T]) Clear() {
+	a.data = nil
+}
+
+// Clone returns a copy of the stack which does not share its backing slice.
+func (a *Stack[T]) Clone() *Stack[T] {
+	data := make([]T, len(a.data))
+	copy(data, a.data)
+	return &Stack[T]{data: data}
+}
+
+// All returns an iterator over the elements of the stack from bottom to top.
+func (a *Stack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range a.data {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the elements of the stack from top to bottom,
+// i.e. in the order they would be popped.
+func (a *Stack[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := len(a.data) - 1; i >= 0; i-- {
+			if !yield(a.data[i]) {
+				return
+			}
+		}
+	}
+}
+
 func RunStack() {
 	intStack := Stack[int]{}
 	intStack.Push(1)
@@ -45,4 +114,13 @@ func RunStack() {
 
 	fmt.Println(stringStack.Pop()) // Output: world, true
 	fmt.Println(stringStack.data)  // Output: 1
+
+	for v := range intStack.Backward() {
+		fmt.Println(v) // Output: 5, 2, 1
+	}
+
+	fmt.Println(intStack.Peek()) // Output: 5, true
+	clone := intStack.Clone()
+	intStack.Clear()
+	fmt.Println(intStack.IsEmpty(), clone.Size()) // Output: true 3
 }
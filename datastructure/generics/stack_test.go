@@ -0,0 +1,102 @@
+package generics
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestStackPushPopPeek(t *testing.T) {
+	var s Stack[int]
+	if _, ok := s.Pop(); ok {
+		t.Error("Pop() on an empty stack = true")
+	}
+	if _, ok := s.Peek(); ok {
+		t.Error("Peek() on an empty stack = true")
+	}
+	for i := range 3 {
+		s.Push(i)
+	}
+	if v, ok := s.Peek(); !ok || v != 2 {
+		t.Errorf("Peek() = %d, %t; want 2, true", v, ok)
+	}
+	if s.Size() != 3 {
+		t.Errorf("Size() = %d after Peek, want 3", s.Size())
+	}
+	for want := 2; want >= 0; want-- {
+		if v, ok := s.Pop(); !ok || v != want {
+			t.Fatalf("Pop() = %d, %t; want %d, true", v, ok, want)
+		}
+	}
+	if !s.IsEmpty() {
+		t.Error("IsEmpty() = false after popping every element")
+	}
+}
+
+func TestStackShrink(t *testing.T) {
+	var s Stack[int]
+	for i := range 1024 {
+		s.Push(i)
+	}
+	for s.Size() > 0 {
+		before := cap(s.data)
+		s.Pop()
+		after := cap(s.data)
+		switch {
+		case before > stackShrinkFloor && s.Size() <= before/4:
+			if after != before/2 {
+				t.Fatalf("capacity %d with %d elements left, want it halved from %d", after, s.Size(), before)
+			}
+		case after != before:
+			t.Fatalf("capacity changed from %d to %d with %d elements left", before, after, s.Size())
+		}
+		if s.Size() > 0 {
+			if v, _ := s.Peek(); v != s.Size()-1 {
+				t.Fatalf("Peek() = %d after shrinking, want %d", v, s.Size()-1)
+			}
+		}
+	}
+	if cap(s.data) > stackShrinkFloor {
+		t.Errorf("capacity %d after popping every element, want at most %d", cap(s.data), stackShrinkFloor)
+	}
+}
+
+func TestStackClear(t *testing.T) {
+	var s Stack[string]
+	s.Push("a")
+	s.Push("b")
+	s.Clear()
+	if !s.IsEmpty() || s.Size() != 0 {
+		t.Errorf("Size() = %d after Clear, want 0", s.Size())
+	}
+	if s.data != nil {
+		t.Error("Clear kept the backing slice")
+	}
+	s.Push("c")
+	if v, ok := s.Pop(); !ok || v != "c" {
+		t.Errorf("Pop() after Clear = %q, %t; want c, true", v, ok)
+	}
+}
+
+func TestStackClone(t *testing.T) {
+	var s Stack[int]
+	for i := range 4 {
+		s.Push(i)
+	}
+	clone := s.Clone()
+	s.Pop()
+	s.Push(10)
+	clone.Push(20)
+
+	if got := slices.Collect(s.All()); !slices.Equal(got, []int{0, 1, 2, 10}) {
+		t.Errorf("original = %v, want [0 1 2 10]", got)
+	}
+	if got := slices.Collect(clone.All()); !slices.Equal(got, []int{0, 1, 2, 3, 20}) {
+		t.Errorf("clone = %v, want [0 1 2 3 20]", got)
+	}
+	if got := slices.Collect(clone.Backward()); !slices.Equal(got, []int{20, 3, 2, 1, 0}) {
+		t.Errorf("clone backward = %v, want [20 3 2 1 0]", got)
+	}
+	if empty := new(Stack[int]).Clone(); !empty.IsEmpty() {
+		t.Error("clone of an empty stack is not empty")
+	}
+}
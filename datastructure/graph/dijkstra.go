@@ -1,54 +1,67 @@
 package graph
 
 import (
-	"container/heap"
 	"math"
 )
 
+// GetShortestPath returns the shortest path from startVertex to endVertex and its length.
+// If endVertex can't be reached, the path is empty and the length is math.MaxInt32.
 func GetShortestPath(startVertex, endVertex *Vertex, g *Graph) (string, int) {
 	if startVertex == endVertex {
 		return startVertex.data, 0
 	}
 	distance := make(map[string]int)
 	prevsVertex := make(map[string]*Vertex)
-	priorityQ := make(PriorityQueue, 0)
-	heap.Init(&priorityQ)
-	heap.Push(&priorityQ, NewGraphQueue(startVertex, 0))
+	verticesByID := make(map[string]*Vertex)
+	settled := make(map[string]struct{})
+	priorityQ := NewIndexedPriorityQueue[string, int]()
+	priorityQ.Push(startVertex.data, 0)
 	distance[startVertex.data] = 0
 	prevsVertex[startVertex.data] = NewVertex("Nil")
-	visitedMap := make(map[string]struct{})
+	verticesByID[startVertex.data] = startVertex
 
 	for _, vertex := range g.vertices {
+		verticesByID[vertex.data] = vertex
 		if vertex != startVertex {
 			distance[vertex.data] = math.MaxInt32
 		}
 	}
 
+	// Every vertex is queued at most once: a shorter path to a queued vertex lowers its
+	// priority in place. Popped vertices are settled and never relaxed again, which also
+	// keeps negative weights from requeueing them forever.
 	for !priorityQ.isEmpty() {
-		currentVertex := heap.Pop(&priorityQ).(*GraphPriorityQueue).vertex
-
-		if _, ok := visitedMap[currentVertex.data]; ok {
-			continue
-		}
-
-		visitedMap[currentVertex.data] = struct{}{}
+		currentID, _, _ := priorityQ.Pop()
+		currentVertex := verticesByID[currentID]
+		settled[currentID] = struct{}{}
 
 		for _, edges := range currentVertex.edges {
-			newDistanceFromCurrentVertex := *edges.weight + distance[currentVertex.data]
 			adjacentVertex := edges.toVertex.data
+			if _, ok := settled[adjacentVertex]; ok {
+				continue
+			}
+			newDistanceFromCurrentVertex := *edges.weight + distance[currentVertex.data]
 			if newDistanceFromCurrentVertex < distance[adjacentVertex] {
 				distance[adjacentVertex] = newDistanceFromCurrentVertex
 				prevsVertex[adjacentVertex] = currentVertex
-				heap.Push(&priorityQ, NewGraphQueue(edges.toVertex, distance[adjacentVertex]))
+				verticesByID[adjacentVertex] = edges.toVertex
+				if priorityQ.Contains(adjacentVertex) {
+					priorityQ.DecreaseKey(adjacentVertex, newDistanceFromCurrentVertex)
+				} else {
+					priorityQ.Push(adjacentVertex, newDistanceFromCurrentVertex)
+				}
 			}
 		}
 	}
 
+	pathVertex, ok := prevsVertex[endVertex.data]
+	if !ok {
+		return "", math.MaxInt32
+	}
+
 	var path string
 	path = endVertex.data
 
-	pathVertex := prevsVertex[endVertex.data]
-
 	for pathVertex.data != "Nil" {
 		path = pathVertex.data + " --> " + path
 		pathVertex = prevsVertex[pathVertex.data]
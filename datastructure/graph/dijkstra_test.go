@@ -0,0 +1,69 @@
+package graph
+
+import (
+	"math"
+	"testing"
+)
+
+func TestGetShortestPath(t *testing.T) {
+	g := NewGraph(true, true)
+	a := g.AddVertex("A")
+	b := g.AddVertex("B")
+	c := g.AddVertex("C")
+	d := g.AddVertex("D")
+	e := g.AddVertex("E")
+	g.AddEdges(a, b, Weight(4))
+	g.AddEdges(a, c, Weight(11))
+	g.AddEdges(b, c, Weight(1))
+	g.AddEdges(b, d, Weight(2))
+	g.AddEdges(b, e, Weight(3))
+	g.AddEdges(c, e, Weight(1))
+	g.AddEdges(e, d, Weight(2))
+	g.AddEdges(d, b, Weight(3))
+
+	tests := []struct {
+		to       *Vertex
+		path     string
+		distance int
+	}{
+		{a, "A", 0},
+		{b, "A --> B", 4},
+		{c, "A --> B --> C", 5},
+		{d, "A --> B --> D", 6},
+		{e, "A --> B --> C --> E", 6},
+	}
+	for _, tt := range tests {
+		path, distance := GetShortestPath(a, tt.to, g)
+		if path != tt.path || distance != tt.distance {
+			t.Errorf("GetShortestPath(A, %s) = %q, %d; want %q, %d", tt.to.data, path, distance, tt.path, tt.distance)
+		}
+	}
+}
+
+func TestGetShortestPathUnreachable(t *testing.T) {
+	g := NewGraph(true, true)
+	a := g.AddVertex("A")
+	b := g.AddVertex("B")
+	c := g.AddVertex("C")
+	g.AddEdges(a, b, Weight(1))
+	g.AddEdges(c, a, Weight(1))
+
+	if path, distance := GetShortestPath(a, c, g); path != "" || distance != math.MaxInt32 {
+		t.Errorf("GetShortestPath(A, C) = %q, %d; want \"\", %d", path, distance, math.MaxInt32)
+	}
+}
+
+func TestGetShortestPathNegativeCycle(t *testing.T) {
+	// Y and Z keep lowering each other's distance, which used to requeue them forever
+	g := NewGraph(true, true)
+	x := g.AddVertex("X")
+	y := g.AddVertex("Y")
+	z := g.AddVertex("Z")
+	g.AddEdges(x, y, Weight(1))
+	g.AddEdges(y, z, Weight(1))
+	g.AddEdges(z, y, Weight(-5))
+
+	if path, distance := GetShortestPath(x, z, g); path != "X --> Y --> Z" || distance != 2 {
+		t.Errorf("GetShortestPath(X, Z) = %q, %d; want \"X --> Y --> Z\", 2", path, distance)
+	}
+}
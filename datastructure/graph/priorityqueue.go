@@ -1,12 +1,195 @@
-// This is an implementation of priority queue for Graph data
+// This is an implementation of priority queues for Graph data
 
 package graph
 
+import (
+	"cmp"
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrKeyNotFound is returned when changing the priority of a key which is not queued.
+	ErrKeyNotFound = errors.New("graph: key not in priority queue")
+	// ErrInvalidPriority is returned when DecreaseKey would increase a priority or vice versa.
+	ErrInvalidPriority = errors.New("graph: invalid priority change")
+)
+
+type pqItem[K comparable, P cmp.Ordered] struct {
+	key      K
+	priority P
+}
+
+// IndexedPriorityQueue is a min priority queue which holds every key at most once and keeps
+// track of the heap position of each key. This lets algorithms like Dijkstra's lower the
+// priority of a queued vertex in place instead of pushing a duplicate entry.
+type IndexedPriorityQueue[K comparable, P cmp.Ordered] struct {
+	items []pqItem[K, P]
+	index map[K]int // position of each key in items
+}
+
+func NewIndexedPriorityQueue[K comparable, P cmp.Ordered]() *IndexedPriorityQueue[K, P] {
+	return &IndexedPriorityQueue[K, P]{index: make(map[K]int)}
+}
+
+func (pq *IndexedPriorityQueue[K, P]) Len() int {
+	return len(pq.items)
+}
+
+func (pq *IndexedPriorityQueue[K, P]) isEmpty() bool {
+	return len(pq.items) == 0
+}
+
+// Contains reports whether the key is queued.
+func (pq *IndexedPriorityQueue[K, P]) Contains(key K) bool {
+	_, ok := pq.index[key]
+	return ok
+}
+
+// Priority returns the priority of the key and whether it is queued.
+func (pq *IndexedPriorityQueue[K, P]) Priority(key K) (P, bool) {
+	i, ok := pq.index[key]
+	if !ok {
+		var zeroValue P
+		return zeroValue, false
+	}
+	return pq.items[i].priority, true
+}
+
+// Push adds the key with the given priority in O(log n). It returns false without changing
+// anything if the key is already queued.
+func (pq *IndexedPriorityQueue[K, P]) Push(key K, priority P) bool {
+	if pq.Contains(key) {
+		return false
+	}
+	pq.items = append(pq.items, pqItem[K, P]{key: key, priority: priority})
+	pq.index[key] = len(pq.items) - 1
+	pq.up(len(pq.items) - 1)
+	return true
+}
+
+// Peek returns the key with the lowest priority without removing it.
+func (pq *IndexedPriorityQueue[K, P]) Peek() (K, P, bool) {
+	if pq.isEmpty() {
+		var zeroKey K
+		var zeroValue P
+		return zeroKey, zeroValue, false
+	}
+	return pq.items[0].key, pq.items[0].priority, true
+}
+
+// Pop removes and returns the key with the lowest priority in O(log n).
+func (pq *IndexedPriorityQueue[K, P]) Pop() (K, P, bool) {
+	if pq.isEmpty() {
+		var zeroKey K
+		var zeroValue P
+		return zeroKey, zeroValue, false
+	}
+	item := pq.removeAt(0)
+	return item.key, item.priority, true
+}
+
+// Delete removes the key in O(log n) and reports whether it was queued.
+func (pq *IndexedPriorityQueue[K, P]) Delete(key K) bool {
+	i, ok := pq.index[key]
+	if !ok {
+		return false
+	}
+	pq.removeAt(i)
+	return true
+}
+
+// DecreaseKey lowers the priority of a queued key in O(log n).
+func (pq *IndexedPriorityQueue[K, P]) DecreaseKey(key K, priority P) error {
+	i, ok := pq.index[key]
+	if !ok {
+		return fmt.Errorf("%w: %v", ErrKeyNotFound, key)
+	}
+	if priority > pq.items[i].priority {
+		return fmt.Errorf("%w: %v is greater than %v", ErrInvalidPriority, priority, pq.items[i].priority)
+	}
+	pq.items[i].priority = priority
+	pq.up(i)
+	return nil
+}
+
+// IncreaseKey raises the priority of a queued key in O(log n).
+func (pq *IndexedPriorityQueue[K, P]) IncreaseKey(key K, priority P) error {
+	i, ok := pq.index[key]
+	if !ok {
+		return fmt.Errorf("%w: %v", ErrKeyNotFound, key)
+	}
+	if priority < pq.items[i].priority {
+		return fmt.Errorf("%w: %v is less than %v", ErrInvalidPriority, priority, pq.items[i].priority)
+	}
+	pq.items[i].priority = priority
+	pq.down(i)
+	return nil
+}
+
+func (pq *IndexedPriorityQueue[K, P]) removeAt(i int) pqItem[K, P] {
+	last := len(pq.items) - 1
+	item := pq.items[i]
+	pq.swap(i, last)
+	pq.items = pq.items[:last]
+	delete(pq.index, item.key)
+	if i != last {
+		pq.down(i)
+		pq.up(i)
+	}
+	return item
+}
+
+func (pq *IndexedPriorityQueue[K, P]) less(i, j int) bool {
+	return pq.items[i].priority < pq.items[j].priority
+}
+
+func (pq *IndexedPriorityQueue[K, P]) swap(i, j int) {
+	pq.items[i], pq.items[j] = pq.items[j], pq.items[i]
+	pq.index[pq.items[i].key] = i
+	pq.index[pq.items[j].key] = j
+}
+
+func (pq *IndexedPriorityQueue[K, P]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !pq.less(i, parent) {
+			return
+		}
+		pq.swap(i, parent)
+		i = parent
+	}
+}
+
+func (pq *IndexedPriorityQueue[K, P]) down(i int) {
+	n := len(pq.items)
+	for {
+		smallest := i
+		if L := 2*i + 1; L < n && pq.less(L, smallest) {
+			smallest = L
+		}
+		if R := 2*i + 2; R < n && pq.less(R, smallest) {
+			smallest = R
+		}
+		if smallest == i {
+			return
+		}
+		pq.swap(i, smallest)
+		i = smallest
+	}
+}
+
+// GraphPriorityQueue is a vertex queued with a priority in a PriorityQueue.
+//
+// Deprecated: use IndexedPriorityQueue.
 type GraphPriorityQueue struct {
 	vertex   *Vertex
 	priority int
 }
 
+// PriorityQueue is a min priority queue of vertices implementing container/heap.Interface.
+//
+// Deprecated: use IndexedPriorityQueue, which can lower the priority of a queued vertex.
 type PriorityQueue []*GraphPriorityQueue
 
 func (pq PriorityQueue) Len() int {
@@ -35,10 +218,9 @@ func (pq *PriorityQueue) Pop() any {
 	return popped
 }
 
-func (pq *PriorityQueue) isEmpty() bool {
-	return len(*pq) == 0
-}
-
+// NewGraphQueue creates a GraphPriorityQueue item for the vertex with the given priority.
+//
+// Deprecated: use IndexedPriorityQueue.
 func NewGraphQueue(v *Vertex, p int) *GraphPriorityQueue {
 	return &GraphPriorityQueue{
 		vertex:   v,
@@ -0,0 +1,160 @@
+package graph
+
+import (
+	"container/heap"
+	"errors"
+	"slices"
+	"testing"
+)
+
+// checkIndex verifies the heap order of the queue and that its index points at every item.
+func checkIndex[K comparable, P int | float64](t *testing.T, pq *IndexedPriorityQueue[K, P]) {
+	t.Helper()
+	if len(pq.index) != len(pq.items) {
+		t.Fatalf("%d keys indexed for %d items", len(pq.index), len(pq.items))
+	}
+	for i, item := range pq.items {
+		if pq.index[item.key] != i {
+			t.Fatalf("key %v indexed at %d, stored at %d", item.key, pq.index[item.key], i)
+		}
+		if i > 0 && pq.less(i, (i-1)/2) {
+			t.Fatalf("item %d comes before its parent", i)
+		}
+	}
+}
+
+func popKeys(t *testing.T, pq *IndexedPriorityQueue[string, int]) []string {
+	t.Helper()
+	var keys []string
+	for pq.Len() > 0 {
+		key, _, _ := pq.Pop()
+		checkIndex(t, pq)
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func newTestQueue(t *testing.T) *IndexedPriorityQueue[string, int] {
+	t.Helper()
+	pq := NewIndexedPriorityQueue[string, int]()
+	for _, item := range []struct {
+		key      string
+		priority int
+	}{{"e", 50}, {"b", 20}, {"d", 40}, {"a", 10}, {"c", 30}, {"f", 60}} {
+		if !pq.Push(item.key, item.priority) {
+			t.Fatalf("Push(%s) = false", item.key)
+		}
+		checkIndex(t, pq)
+	}
+	return pq
+}
+
+func TestIndexedPriorityQueuePushPop(t *testing.T) {
+	pq := newTestQueue(t)
+	if pq.Push("a", 0) {
+		t.Error("Push of a queued key = true")
+	}
+	if p, ok := pq.Priority("a"); !ok || p != 10 {
+		t.Errorf("Priority(a) = %d, %t; want 10, true after a duplicate Push", p, ok)
+	}
+	if key, p, ok := pq.Peek(); !ok || key != "a" || p != 10 {
+		t.Errorf("Peek() = %s, %d, %t; want a, 10, true", key, p, ok)
+	}
+	if got := popKeys(t, pq); !slices.Equal(got, []string{"a", "b", "c", "d", "e", "f"}) {
+		t.Errorf("popped %v, want [a b c d e f]", got)
+	}
+}
+
+func TestIndexedPriorityQueueEmpty(t *testing.T) {
+	pq := NewIndexedPriorityQueue[string, int]()
+	if _, _, ok := pq.Pop(); ok {
+		t.Error("Pop() on an empty queue = true")
+	}
+	if _, _, ok := pq.Peek(); ok {
+		t.Error("Peek() on an empty queue = true")
+	}
+	pq.Push("a", 1)
+	pq.Pop()
+	if _, _, ok := pq.Pop(); ok {
+		t.Error("Pop() on an emptied queue = true")
+	}
+	if pq.Contains("a") {
+		t.Error("Contains(a) = true after popping it")
+	}
+}
+
+func TestIndexedPriorityQueueDecreaseKey(t *testing.T) {
+	pq := newTestQueue(t)
+	if err := pq.DecreaseKey("f", 5); err != nil {
+		t.Fatalf("DecreaseKey(f, 5) = %v", err)
+	}
+	checkIndex(t, pq)
+	if err := pq.DecreaseKey("e", 25); err != nil {
+		t.Fatalf("DecreaseKey(e, 25) = %v", err)
+	}
+	checkIndex(t, pq)
+	if err := pq.DecreaseKey("d", 41); !errors.Is(err, ErrInvalidPriority) {
+		t.Errorf("DecreaseKey(d, 41) = %v, want ErrInvalidPriority", err)
+	}
+	if err := pq.DecreaseKey("x", 1); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("DecreaseKey(x, 1) = %v, want ErrKeyNotFound", err)
+	}
+	if got := popKeys(t, pq); !slices.Equal(got, []string{"f", "a", "b", "e", "c", "d"}) {
+		t.Errorf("popped %v, want [f a b e c d]", got)
+	}
+}
+
+func TestIndexedPriorityQueueIncreaseKey(t *testing.T) {
+	pq := newTestQueue(t)
+	if err := pq.IncreaseKey("a", 55); err != nil {
+		t.Fatalf("IncreaseKey(a, 55) = %v", err)
+	}
+	checkIndex(t, pq)
+	if err := pq.IncreaseKey("c", 30); err != nil {
+		t.Fatalf("IncreaseKey(c, 30) = %v", err)
+	}
+	if err := pq.IncreaseKey("b", 19); !errors.Is(err, ErrInvalidPriority) {
+		t.Errorf("IncreaseKey(b, 19) = %v, want ErrInvalidPriority", err)
+	}
+	if err := pq.IncreaseKey("x", 1); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("IncreaseKey(x, 1) = %v, want ErrKeyNotFound", err)
+	}
+	if got := popKeys(t, pq); !slices.Equal(got, []string{"b", "c", "d", "e", "a", "f"}) {
+		t.Errorf("popped %v, want [b c d e a f]", got)
+	}
+}
+
+func TestIndexedPriorityQueueDelete(t *testing.T) {
+	pq := newTestQueue(t)
+	for _, key := range []string{"a", "f", "c"} {
+		if !pq.Delete(key) {
+			t.Fatalf("Delete(%s) = false", key)
+		}
+		checkIndex(t, pq)
+		if pq.Contains(key) {
+			t.Errorf("Contains(%s) = true after Delete", key)
+		}
+	}
+	if pq.Delete("a") {
+		t.Error("Delete of a deleted key = true")
+	}
+	if !pq.Contains("b") || pq.Len() != 3 {
+		t.Errorf("Contains(b), Len() = %t, %d; want true, 3", pq.Contains("b"), pq.Len())
+	}
+	if got := popKeys(t, pq); !slices.Equal(got, []string{"b", "d", "e"}) {
+		t.Errorf("popped %v, want [b d e]", got)
+	}
+}
+
+func TestDeprecatedPriorityQueue(t *testing.T) {
+	a, b := NewVertex("a"), NewVertex("b")
+	pq := make(PriorityQueue, 0)
+	heap.Push(&pq, NewGraphQueue(b, 2))
+	heap.Push(&pq, NewGraphQueue(a, 1))
+	if v := heap.Pop(&pq).(*GraphPriorityQueue).vertex; v != a {
+		t.Errorf("popped %s, want a", v.data)
+	}
+	if pq.Len() != 1 {
+		t.Errorf("Len() = %d, want 1", pq.Len())
+	}
+}
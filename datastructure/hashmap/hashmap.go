@@ -1,13 +1,22 @@
 package hashmap
 
-import "fmt"
+import (
+	"fmt"
+	"iter"
+
+	"github.com/dev-crusader/data-structures-and-algorithms/datastructure/generics"
+)
 
 const Size = 10
 
+// HashMap maps string keys to values of any type. It implements generics.Map[string, any].
 type HashMap struct {
 	buckets [Size]*LinkListed
+	count   int
 }
 
+var _ generics.Map[string, any] = (*HashMap)(nil)
+
 type LinkListed struct {
 	head *ListNode
 }
@@ -18,15 +27,54 @@ type ListNode struct {
 	next  *ListNode
 }
 
-// Insert function implements insertion of key to hashMap
-func (h *HashMap) Insert(key string, value any) {
+// NewHashMap creates a new, empty HashMap.
+func NewHashMap() *HashMap {
+	hm := &HashMap{}
+	for k := range hm.buckets {
+		hm.buckets[k] = &LinkListed{}
+	}
+	return hm
+}
+
+// Insert function implements insertion of key to hashMap and reports whether the key was added.
+// The value of an existing key is not overwritten, use Put for that.
+func (h *HashMap) Insert(key string, value any) bool {
+	index := generateHash(key)
+	if !h.buckets[index].Insert(key, value) {
+		return false
+	}
+	h.count++
+	return true
+}
+
+// Put inserts or replaces the value for the key, returning the previous value if any.
+func (h *HashMap) Put(key string, value any) (any, bool) {
 	index := generateHash(key)
-	h.buckets[index].Insert(key, value)
+	if node := h.buckets[index].find(key); node != nil {
+		previous := node.value
+		node.value = value
+		return previous, true
+	}
+	h.Insert(key, value)
+	return nil, false
+}
+
+// Get returns the value stored for the key and whether it was found.
+func (h *HashMap) Get(key string) (any, bool) {
+	if node := h.buckets[generateHash(key)].find(key); node != nil {
+		return node.value, true
+	}
+	return nil, false
 }
 
-func (h *HashMap) Delete(key string) {
+// Delete deletes the key and reports whether it was present.
+func (h *HashMap) Delete(key string) bool {
 	index := generateHash(key)
-	h.buckets[index].Delete(key)
+	if !h.buckets[index].Delete(key) {
+		return false
+	}
+	h.count--
+	return true
 }
 
 func (h *HashMap) Search(key string) bool {
@@ -34,52 +82,70 @@ func (h *HashMap) Search(key string) bool {
 	return h.buckets[searchIndex].Search(key)
 }
 
-// Insert function insert the key into Linkedlist
-func (l *LinkListed) Insert(key string, value any) {
-	if !l.Search(key) {
-		currentNode := &ListNode{key: key, value: value}
-		currentNode.next = l.head
-		l.head = currentNode
-	} else {
-		fmt.Printf("Insert Key: %s already exists\n", key)
+// Len returns the number of entries stored in the map.
+func (h *HashMap) Len() int {
+	return h.count
+}
+
+// All returns an iterator over the key-value pairs of the map.
+func (h *HashMap) All() iter.Seq2[string, any] {
+	return func(yield func(string, any) bool) {
+		for _, bucket := range h.buckets {
+			for currentNode := bucket.head; currentNode != nil; {
+				next := currentNode.next
+				if !yield(currentNode.key, currentNode.value) {
+					return
+				}
+				currentNode = next
+			}
+		}
 	}
 }
 
-func (l *LinkListed) Delete(key string) {
+// Insert function insert the key into Linkedlist and reports whether it was added.
+func (l *LinkListed) Insert(key string, value any) bool {
+	if l.Search(key) {
+		return false
+	}
+	currentNode := &ListNode{key: key, value: value}
+	currentNode.next = l.head
+	l.head = currentNode
+	return true
+}
+
+// Delete removes the key from the Linkedlist and reports whether it was present.
+func (l *LinkListed) Delete(key string) bool {
 	currentNode := l.head
+	if currentNode == nil {
+		return false
+	}
 
 	if currentNode.key == key {
 		l.head = currentNode.next
-		return
+		return true
 	}
 
 	for currentNode.next != nil {
 		if currentNode.next.key == key {
 			currentNode.next = currentNode.next.next
-			return
+			return true
 		}
 		currentNode = currentNode.next
 	}
-	fmt.Printf("Delete Key: %s does not exists\n", key)
+	return false
 }
 
 func (l *LinkListed) Search(key string) bool {
-	currentNode := l.head
-	for currentNode != nil {
-		if currentNode.key == key {
-			return true
-		}
-		currentNode = currentNode.next
-	}
-	return false
+	return l.find(key) != nil
 }
 
-func initMap() *HashMap {
-	hm := &HashMap{}
-	for k := range hm.buckets {
-		hm.buckets[k] = &LinkListed{}
+func (l *LinkListed) find(key string) *ListNode {
+	for currentNode := l.head; currentNode != nil; currentNode = currentNode.next {
+		if currentNode.key == key {
+			return currentNode
+		}
 	}
-	return hm
+	return nil
 }
 
 func generateHash(key string) int {
@@ -87,11 +153,11 @@ func generateHash(key string) int {
 	for _, v := range key {
 		sum += int(v)
 	}
-	return sum % len(key)
+	return sum % Size
 }
 
 func InitHashMap() {
-	m := initMap()
+	m := NewHashMap()
 	m.Insert("Jack", 32)
 	m.Insert("Pete", 44)
 	m.Insert("Ryan", 76)
@@ -105,6 +171,10 @@ func InitHashMap() {
 		location string
 	}{26, "Dallas"})
 	fmt.Println(m.Search("Jack"))
-	m.Delete("Bard")
+	fmt.Println(m.Get("Kyle"))
+	fmt.Println(m.Put("Jim", "Carrey"))
+	fmt.Println(m.Delete("Bard"))
+	fmt.Println(m.Delete("Bard"))
 	m.Insert("Jill", "Cody")
+	fmt.Println("Len:", m.Len())
 }
@@ -0,0 +1,20 @@
+package hashmap
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dev-crusader/data-structures-and-algorithms/datastructure/generics/maptest"
+)
+
+func TestHashMap(t *testing.T) {
+	for _, n := range []int{2, 10, 1000} {
+		entries := make(map[string]any, n)
+		for i := range n {
+			entries[fmt.Sprintf("key-%d", i)] = i
+		}
+		if err := maptest.TestMap(NewHashMap(), entries); err != nil {
+			t.Errorf("%d entries: %v", n, err)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package heap
+
+import "fmt"
+
+// DaryElement is the handle of a value stored in a DaryHeap.
+type DaryElement[T any] struct {
+	value T
+	index int
+	owner *owner
+}
+
+// Value returns the value of the element.
+func (e *DaryElement[T]) Value() T {
+	return e.value
+}
+
+// DaryHeap is an implicit heap in which every node has up to d children.
+type DaryHeap[T any] struct {
+	d     int
+	items []*DaryElement[T]
+	cmp   func(a, b T) int
+	owner *owner
+}
+
+// NewDary creates an empty heap with d children per node, ordered by cmp as described at New.
+// A d less than 2 is raised to 2.
+func NewDary[T any](d int, cmp func(a, b T) int) *DaryHeap[T] {
+	if d < 2 {
+		d = 2
+	}
+	return &DaryHeap[T]{d: d, cmp: cmp, owner: &owner{}}
+}
+
+func (h *DaryHeap[T]) less(i, j int) bool {
+	return h.cmp(h.items[i].value, h.items[j].value) < 0
+}
+
+func (h *DaryHeap[T]) swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].index = i
+	h.items[j].index = j
+}
+
+func (h *DaryHeap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / h.d
+		if !h.less(i, parent) {
+			return
+		}
+		h.swap(i, parent)
+		i = parent
+	}
+}
+
+// down moves the item at index i towards the leaves and reports whether it moved.
+func (h *DaryHeap[T]) down(i int) bool {
+	start := i
+	for {
+		first := i
+		for c := h.d*i + 1; c <= h.d*i+h.d && c < len(h.items); c++ {
+			if h.less(c, first) {
+				first = c
+			}
+		}
+		if first == i {
+			return i > start
+		}
+		h.swap(i, first)
+		i = first
+	}
+}
+
+// Insert adds v in O(log_d n) and returns its handle.
+func (h *DaryHeap[T]) Insert(v T) *DaryElement[T] {
+	e := &DaryElement[T]{value: v, index: len(h.items), owner: h.owner}
+	h.items = append(h.items, e)
+	h.up(e.index)
+	return e
+}
+
+// Push adds v in O(log_d n).
+func (h *DaryHeap[T]) Push(v T) {
+	h.Insert(v)
+}
+
+// Peek returns the first value without removing it.
+func (h *DaryHeap[T]) Peek() (T, bool) {
+	if len(h.items) == 0 {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return h.items[0].value, true
+}
+
+// Pop removes and returns the first value in O(d log_d n).
+func (h *DaryHeap[T]) Pop() (T, bool) {
+	if len(h.items) == 0 {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return h.removeAt(0).value, true
+}
+
+// Len returns the number of values in the heap.
+func (h *DaryHeap[T]) Len() int {
+	return len(h.items)
+}
+
+// DecreaseKey replaces the value of e with v in O(log_d n).
+func (h *DaryHeap[T]) DecreaseKey(e *DaryElement[T], v T) error {
+	if !belongsTo(&e.owner, h.owner) {
+		return ErrForeignHandle
+	}
+	if h.cmp(v, e.value) > 0 {
+		return fmt.Errorf("%w: %v after %v", ErrInvalidKey, v, e.value)
+	}
+	e.value = v
+	h.up(e.index)
+	return nil
+}
+
+// Delete removes the value of e in O(d log_d n).
+func (h *DaryHeap[T]) Delete(e *DaryElement[T]) error {
+	if !belongsTo(&e.owner, h.owner) {
+		return ErrForeignHandle
+	}
+	h.removeAt(e.index)
+	return nil
+}
+
+func (h *DaryHeap[T]) removeAt(i int) *DaryElement[T] {
+	last := len(h.items) - 1
+	e := h.items[i]
+	h.swap(i, last)
+	h.items[last] = nil
+	h.items = h.items[:last]
+	if i != last && !h.down(i) {
+		h.up(i)
+	}
+	e.owner = nil
+	return e
+}
+
+// Meld moves all values of other into h in O(n + m) by heapifying the combined items.
+// Both heaps must use the same order.
+func (h *DaryHeap[T]) Meld(other *DaryHeap[T]) error {
+	if h == other {
+		return ErrSameHeap
+	}
+	for _, e := range other.items {
+		e.index = len(h.items)
+		h.items = append(h.items, e)
+	}
+	for i := (len(h.items) - 2) / h.d; i >= 0; i-- {
+		h.down(i)
+	}
+	other.owner.forward = h.owner
+	other.owner = &owner{}
+	other.items = nil
+	return nil
+}
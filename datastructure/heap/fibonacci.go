@@ -0,0 +1,245 @@
+package heap
+
+import "fmt"
+
+// FibonacciNode is the handle of a value stored in a FibonacciHeap.
+type FibonacciNode[T any] struct {
+	value  T
+	parent *FibonacciNode[T]
+	child  *FibonacciNode[T]
+	left   *FibonacciNode[T] // siblings form a circular doubly linked list
+	right  *FibonacciNode[T]
+	degree int
+	mark   bool // the node lost a child since it became the child of its parent
+	owner  *owner
+}
+
+// Value returns the value of the node.
+func (n *FibonacciNode[T]) Value() T {
+	return n.value
+}
+
+// FibonacciHeap is a forest of heap ordered trees whose roots form a circular list. Push,
+// Meld and DecreaseKey only add trees to the root list, the work of combining trees of equal
+// degree is postponed until Pop.
+type FibonacciHeap[T any] struct {
+	min   *FibonacciNode[T]
+	size  int
+	cmp   func(a, b T) int
+	owner *owner
+}
+
+// NewFibonacci creates an empty Fibonacci heap ordered by cmp as described at New.
+func NewFibonacci[T any](cmp func(a, b T) int) *FibonacciHeap[T] {
+	return &FibonacciHeap[T]{cmp: cmp, owner: &owner{}}
+}
+
+// splice joins the circular lists containing a and b.
+func splice[T any](a, b *FibonacciNode[T]) {
+	a.right, b.right = b.right, a.right
+	a.right.left = a
+	b.right.left = b
+}
+
+// unlink removes n from its circular list, leaving it in a list of its own.
+func unlink[T any](n *FibonacciNode[T]) {
+	n.left.right = n.right
+	n.right.left = n.left
+	n.left, n.right = n, n
+}
+
+// addRoot adds the list containing n to the root list and updates the minimum.
+func (h *FibonacciHeap[T]) addRoot(n *FibonacciNode[T]) {
+	if h.min == nil {
+		h.min = n
+		return
+	}
+	splice(h.min, n)
+	if h.cmp(n.value, h.min.value) < 0 {
+		h.min = n
+	}
+}
+
+// Insert adds v in O(1) and returns its handle.
+func (h *FibonacciHeap[T]) Insert(v T) *FibonacciNode[T] {
+	n := &FibonacciNode[T]{value: v, owner: h.owner}
+	n.left, n.right = n, n
+	h.addRoot(n)
+	h.size++
+	return n
+}
+
+// Push adds v in O(1).
+func (h *FibonacciHeap[T]) Push(v T) {
+	h.Insert(v)
+}
+
+// Peek returns the first value without removing it.
+func (h *FibonacciHeap[T]) Peek() (T, bool) {
+	if h.min == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return h.min.value, true
+}
+
+// Pop removes and returns the first value in O(log n) amortized.
+func (h *FibonacciHeap[T]) Pop() (T, bool) {
+	if h.min == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	z := h.min
+	if z.child != nil {
+		child := z.child
+		for c := child; ; {
+			c.parent, c.mark = nil, false
+			if c = c.right; c == child {
+				break
+			}
+		}
+		splice(z, child)
+		z.child = nil
+	}
+	if z.right == z {
+		h.min = nil
+	} else {
+		h.min = z.right
+		unlink(z)
+		h.consolidate()
+	}
+	h.size--
+	z.owner = nil
+	return z.value, true
+}
+
+// consolidate links roots of equal degree until all degrees differ and finds the new minimum.
+func (h *FibonacciHeap[T]) consolidate() {
+	var roots []*FibonacciNode[T]
+	for n := h.min; ; {
+		roots = append(roots, n)
+		if n = n.right; n == h.min {
+			break
+		}
+	}
+
+	var byDegree []*FibonacciNode[T]
+	for _, x := range roots {
+		d := x.degree
+		for d < len(byDegree) && byDegree[d] != nil {
+			y := byDegree[d]
+			if h.cmp(y.value, x.value) < 0 {
+				x, y = y, x
+			}
+			h.link(y, x)
+			byDegree[d] = nil
+			d++
+		}
+		for len(byDegree) <= d {
+			byDegree = append(byDegree, nil)
+		}
+		byDegree[d] = x
+	}
+
+	h.min = nil
+	for _, n := range byDegree {
+		if n != nil && (h.min == nil || h.cmp(n.value, h.min.value) < 0) {
+			h.min = n
+		}
+	}
+}
+
+// link makes the root y a child of the root x.
+func (h *FibonacciHeap[T]) link(y, x *FibonacciNode[T]) {
+	unlink(y)
+	y.parent, y.mark = x, false
+	if x.child == nil {
+		x.child = y
+	} else {
+		splice(x.child, y)
+	}
+	x.degree++
+}
+
+// cut moves n from the children of its parent to the root list.
+func (h *FibonacciHeap[T]) cut(n *FibonacciNode[T]) {
+	parent := n.parent
+	if parent.child == n {
+		if n.right == n {
+			parent.child = nil
+		} else {
+			parent.child = n.right
+		}
+	}
+	unlink(n)
+	parent.degree--
+	n.parent, n.mark = nil, false
+	h.addRoot(n)
+}
+
+// cascadingCut cuts n once it has lost its second child, and continues with its parent.
+func (h *FibonacciHeap[T]) cascadingCut(n *FibonacciNode[T]) {
+	for n.parent != nil {
+		if !n.mark {
+			n.mark = true
+			return
+		}
+		parent := n.parent
+		h.cut(n)
+		n = parent
+	}
+}
+
+// Len returns the number of values in the heap.
+func (h *FibonacciHeap[T]) Len() int {
+	return h.size
+}
+
+// DecreaseKey replaces the value of n with v in O(1) amortized.
+func (h *FibonacciHeap[T]) DecreaseKey(n *FibonacciNode[T], v T) error {
+	if !belongsTo(&n.owner, h.owner) {
+		return ErrForeignHandle
+	}
+	if h.cmp(v, n.value) > 0 {
+		return fmt.Errorf("%w: %v after %v", ErrInvalidKey, v, n.value)
+	}
+	n.value = v
+	if parent := n.parent; parent != nil && h.cmp(v, parent.value) < 0 {
+		h.cut(n)
+		h.cascadingCut(parent)
+	}
+	if h.cmp(v, h.min.value) < 0 {
+		h.min = n
+	}
+	return nil
+}
+
+// Delete removes the value of n in O(log n) amortized. The node is cut to the root list and
+// popped as if it was the minimum.
+func (h *FibonacciHeap[T]) Delete(n *FibonacciNode[T]) error {
+	if !belongsTo(&n.owner, h.owner) {
+		return ErrForeignHandle
+	}
+	if parent := n.parent; parent != nil {
+		h.cut(n)
+		h.cascadingCut(parent)
+	}
+	h.min = n
+	h.Pop()
+	return nil
+}
+
+// Meld moves all values of other into h in O(1). Both heaps must use the same order.
+func (h *FibonacciHeap[T]) Meld(other *FibonacciHeap[T]) error {
+	if h == other {
+		return ErrSameHeap
+	}
+	if other.min != nil {
+		h.addRoot(other.min)
+	}
+	h.size += other.size
+	other.owner.forward = h.owner
+	other.owner = &owner{}
+	other.min, other.size = nil, 0
+	return nil
+}
@@ -1,98 +1,227 @@
+/*
+	Package heap implements a generic binary heap.
+
+	The order of a heap is given by a comparison function in the style of cmp.Compare: the value
+	comparing lowest sits at the top and is returned first by Pop. NewMin and NewMax cover ordered
+	types, any other order is a matter of passing a custom function to New or FromSlice.
+*/
+
 package heap
 
-import "fmt"
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrIndexOutOfRange is returned by the methods addressing a heap slot by index.
+var ErrIndexOutOfRange = errors.New("heap: index out of range")
+
+// Heap is a binary heap stored in a slice which grows as needed. The zero value is not usable,
+// create heaps with New, NewMin, NewMax or FromSlice.
+type Heap[T any] struct {
+	items []T
+	cmp   func(a, b T) int
+}
 
-type Heap struct {
-	List []int
-	size int
+// New creates an empty heap ordered by cmp, which returns a negative number when a should be
+// popped before b, a positive number when b should be popped before a and zero otherwise.
+func New[T any](cmp func(a, b T) int) *Heap[T] {
+	return &Heap[T]{cmp: cmp}
 }
 
-func initializeHeap(s int) *Heap {
-	return &Heap{
-		List: make([]int, s),
-	}
+// NewMin creates an empty heap popping the smallest value first.
+func NewMin[T cmp.Ordered]() *Heap[T] {
+	return New(cmp.Compare[T])
 }
 
-func (h *Heap) isFull() bool {
-	return h.size == len(h.List)
+// NewMax creates an empty heap popping the largest value first.
+func NewMax[T cmp.Ordered]() *Heap[T] {
+	return New(func(a, b T) int {
+		return cmp.Compare(b, a)
+	})
 }
 
-func (h *Heap) Insert(a int) {
-	if !h.isFull() {
-		h.List[h.size] = a
-		h.size++
-	} else {
-		fmt.Println("Heap Size is full!!")
+// FromSlice creates a heap ordered by cmp holding the given items. The slice is heapified
+// in place in O(n) and is owned by the heap afterwards.
+func FromSlice[T any](items []T, cmp func(a, b T) int) *Heap[T] {
+	h := &Heap[T]{items: items, cmp: cmp}
+	for i := h.getParent(len(items) - 1); i >= 0; i-- {
+		h.down(i, len(items))
 	}
+	return h
 }
 
-func (h *Heap) getRightChild(index int) int {
+func (h *Heap[T]) getRightChild(index int) int {
 	return 2*index + 2
 }
 
-func (h *Heap) getLeftChild(index int) int {
+func (h *Heap[T]) getLeftChild(index int) int {
 	return 2*index + 1
 }
 
-func (h *Heap) getParent(index int) int {
+func (h *Heap[T]) getParent(index int) int {
 	return (index - 1) / 2
 }
 
-func (h *Heap) BuildHeap() {
-	for i := h.getParent(h.size); i >= 0; i-- {
-		h.maxHeapify(i)
+func (h *Heap[T]) less(i, j int) bool {
+	return h.cmp(h.items[i], h.items[j]) < 0
+}
+
+func (h *Heap[T]) swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+}
+
+// up moves the item at index i towards the root until its parent comes before it.
+func (h *Heap[T]) up(i int) {
+	for i > 0 {
+		parent := h.getParent(i)
+		if !h.less(i, parent) {
+			return
+		}
+		h.swap(i, parent)
+		i = parent
 	}
 }
 
-func (h *Heap) maxHeapify(i int) {
-	L := h.getLeftChild(i)
-	R := h.getRightChild(i)
-	var largest int
+// down moves the item at index i towards the leaves of the first n items until both children
+// come after it. It reports whether the item moved.
+func (h *Heap[T]) down(i, n int) bool {
+	start := i
+	for {
+		first := i
+		if L := h.getLeftChild(i); L < n && h.less(L, first) {
+			first = L
+		}
+		if R := h.getRightChild(i); R < n && h.less(R, first) {
+			first = R
+		}
+		if first == i {
+			return i > start
+		}
+		h.swap(i, first)
+		i = first
+	}
+}
+
+// Push adds v to the heap in O(log n).
+func (h *Heap[T]) Push(v T) {
+	h.items = append(h.items, v)
+	h.up(len(h.items) - 1)
+}
 
-	if L < h.size && h.List[L] > h.List[i] {
-		largest = L
-	} else {
-		largest = i
+// Pop removes and returns the top of the heap in O(log n).
+func (h *Heap[T]) Pop() (T, bool) {
+	if len(h.items) == 0 {
+		var zeroValue T
+		return zeroValue, false
 	}
+	v, _ := h.Remove(0)
+	return v, true
+}
 
-	if R < h.size && h.List[R] > h.List[largest] {
-		largest = R
+// Peek returns the top of the heap without removing it.
+func (h *Heap[T]) Peek() (T, bool) {
+	if len(h.items) == 0 {
+		var zeroValue T
+		return zeroValue, false
 	}
+	return h.items[0], true
+}
+
+// Len returns the number of values in the heap.
+func (h *Heap[T]) Len() int {
+	return len(h.items)
+}
 
-	if i != largest {
-		h.List[i], h.List[largest] = h.List[largest], h.List[i]
-		h.maxHeapify(largest)
+func (h *Heap[T]) checkIndex(index int) error {
+	if index < 0 || index >= len(h.items) {
+		return fmt.Errorf("%w: %d with length %d", ErrIndexOutOfRange, index, len(h.items))
 	}
+	return nil
 }
 
-// HeapSort function uses maxheap to sort elements
-func (h *Heap) HeapSort() []int {
-	if h.size == 0 {
-		fmt.Println("Heap is empty")
-		return []int{}
+// Fix restores the heap order after the value at the given index has been changed in place,
+// in O(log n).
+func (h *Heap[T]) Fix(index int) error {
+	if err := h.checkIndex(index); err != nil {
+		return err
+	}
+	if !h.down(index, len(h.items)) {
+		h.up(index)
 	}
+	return nil
+}
 
-	result := make([]int, h.size)
+// Remove removes and returns the value at the given index in O(log n).
+func (h *Heap[T]) Remove(index int) (T, error) {
+	if err := h.checkIndex(index); err != nil {
+		var zeroValue T
+		return zeroValue, err
+	}
+	last := len(h.items) - 1
+	v := h.items[index]
+	if index != last {
+		h.swap(index, last)
+	}
+	var zeroValue T
+	h.items[last] = zeroValue // let the removed value be garbage collected
+	h.items = h.items[:last]
+	if index != last {
+		if !h.down(index, last) {
+			h.up(index)
+		}
+	}
+	return v, nil
+}
 
-	for i := h.size - 1; i >= 0; i-- {
-		result[i] = h.List[0]
-		h.List[i], h.List[0] = h.List[0], h.List[i]
-		h.size--
-		h.maxHeapify(0)
+// HeapSort empties the heap and returns its values sorted in place on the backing slice,
+// without allocating. The top of the heap is swapped to the end on every step, so the values
+// come out in the reverse of their Pop order, which is ascending for a max-heap. Use Sorted
+// to keep the heap.
+func (h *Heap[T]) HeapSort() []T {
+	result := h.items
+	for n := len(result) - 1; n > 0; n-- {
+		h.swap(0, n)
+		h.down(0, n)
 	}
+	h.items = nil
 	return result
 }
 
+// Sorted returns the values of the heap in Pop order, leaving the heap unchanged. It heap
+// sorts a copy of the items, which are a valid heap already, in O(n log n).
+func (h *Heap[T]) Sorted() []T {
+	result := h.clone().HeapSort()
+	slices.Reverse(result)
+	return result
+}
+
+func (h *Heap[T]) clone() *Heap[T] {
+	return &Heap[T]{items: slices.Clone(h.items), cmp: h.cmp}
+}
+
 func CallHeap() {
-	t := initializeHeap(10)
-	fmt.Println(t)
+	t := NewMax[int]()
 	for _, v := range []int{29, 45, 93, 61, 22, 62, 87, 5, 41, 14, 32} {
-		t.Insert(v)
+		t.Push(v)
 	}
-	fmt.Println(t.List)
-	t.BuildHeap()
-	fmt.Println(t.List)
-	result := t.HeapSort()
-	fmt.Println(result)
+	fmt.Println(t.Peek())
+	fmt.Println(t.Pop())
+
+	// order words by length, the shortest first
+	words := FromSlice([]string{"heap", "go", "generic", "binary", "slice"}, func(a, b string) int {
+		return cmp.Compare(len(a), len(b))
+	})
+	fmt.Println(words.Remove(words.Len() - 1))
+	for words.Len() > 0 {
+		w, _ := words.Pop()
+		fmt.Print(w, " ")
+	}
+	fmt.Println()
 
+	fmt.Println(t.Sorted(), t.Len())
+	result := t.HeapSort()
+	fmt.Println(result, t.Len())
 }
@@ -0,0 +1,134 @@
+package heap
+
+import (
+	"cmp"
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+// checkHeap verifies that no item comes before its parent.
+func checkHeap[T any](t *testing.T, h *Heap[T]) {
+	t.Helper()
+	for i := 1; i < len(h.items); i++ {
+		if h.less(i, h.getParent(i)) {
+			t.Fatalf("item %d comes before its parent %d", i, h.getParent(i))
+		}
+	}
+}
+
+// popAll pops every value of the heap.
+func popAll[T any](h *Heap[T]) []T {
+	var values []T
+	for h.Len() > 0 {
+		v, _ := h.Pop()
+		values = append(values, v)
+	}
+	return values
+}
+
+func randomInts(n int) []int {
+	r := rand.New(rand.NewPCG(1, uint64(n)))
+	values := make([]int, n)
+	for i := range values {
+		values[i] = r.IntN(n + 1)
+	}
+	return values
+}
+
+func TestFromSlice(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 3, 10, 100, 1000} {
+		values := randomInts(n)
+		h := FromSlice(slices.Clone(values), cmp.Compare[int])
+		checkHeap(t, h)
+		if h.Len() != n {
+			t.Fatalf("Len() = %d, want %d", h.Len(), n)
+		}
+		slices.Sort(values)
+		if got := popAll(h); !slices.Equal(got, values) {
+			t.Fatalf("FromSlice of %d values popped %v, want %v", n, got, values)
+		}
+	}
+}
+
+func TestFromSliceMax(t *testing.T) {
+	h := FromSlice([]string{"b", "d", "a", "c"}, func(a, b string) int { return cmp.Compare(b, a) })
+	checkHeap(t, h)
+	if got := popAll(h); !slices.Equal(got, []string{"d", "c", "b", "a"}) {
+		t.Errorf("popped %v, want [d c b a]", got)
+	}
+}
+
+func TestFix(t *testing.T) {
+	values := randomInts(200)
+	for _, delta := range []int{-1000, -3, 3, 1000} {
+		for _, index := range []int{0, 1, 50, 199} {
+			h := FromSlice(slices.Clone(values), cmp.Compare[int])
+			h.items[index] += delta
+			if err := h.Fix(index); err != nil {
+				t.Fatalf("Fix(%d) = %v", index, err)
+			}
+			checkHeap(t, h)
+
+			want := slices.Clone(h.items)
+			slices.Sort(want)
+			if got := popAll(h); !slices.Equal(got, want) {
+				t.Fatalf("Fix(%d) after changing by %d popped %v, want %v", index, delta, got, want)
+			}
+		}
+	}
+}
+
+func TestRemove(t *testing.T) {
+	values := randomInts(100)
+	for _, index := range []int{0, 1, 42, 98, 99} {
+		h := FromSlice(slices.Clone(values), cmp.Compare[int])
+		want := slices.Clone(h.items)
+		removed, err := h.Remove(index)
+		if err != nil {
+			t.Fatalf("Remove(%d) = %v", index, err)
+		}
+		if removed != want[index] {
+			t.Errorf("Remove(%d) = %d, want %d", index, removed, want[index])
+		}
+		checkHeap(t, h)
+
+		want = slices.Delete(want, index, index+1)
+		slices.Sort(want)
+		if got := popAll(h); !slices.Equal(got, want) {
+			t.Fatalf("after Remove(%d) popped %v, want %v", index, got, want)
+		}
+	}
+}
+
+func TestRemoveLast(t *testing.T) {
+	h := FromSlice([]int{1, 2, 3}, cmp.Compare[int])
+	if v, err := h.Remove(2); err != nil || v != 3 {
+		t.Fatalf("Remove(2) = %d, %v; want 3, nil", v, err)
+	}
+	if v, err := h.Remove(1); err != nil || v != 2 {
+		t.Fatalf("Remove(1) = %d, %v; want 2, nil", v, err)
+	}
+	if v, err := h.Remove(0); err != nil || v != 1 {
+		t.Fatalf("Remove(0) = %d, %v; want 1, nil", v, err)
+	}
+	if h.Len() != 0 {
+		t.Errorf("Len() = %d after removing every value", h.Len())
+	}
+}
+
+func TestOutOfRange(t *testing.T) {
+	h := FromSlice([]int{1, 2, 3}, cmp.Compare[int])
+	for _, index := range []int{-1, 3, 4} {
+		if err := h.Fix(index); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("Fix(%d) = %v, want ErrIndexOutOfRange", index, err)
+		}
+		if _, err := h.Remove(index); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("Remove(%d) = %v, want ErrIndexOutOfRange", index, err)
+		}
+	}
+	if h.Len() != 3 {
+		t.Errorf("Len() = %d after out of range calls, want 3", h.Len())
+	}
+}
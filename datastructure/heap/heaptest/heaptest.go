@@ -0,0 +1,232 @@
+// Package heaptest implements conformance checks for the priority queues of package heap,
+// in the spirit of testing/fstest. Any implementation can be run against them, e.g.
+//
+//	err := heaptest.TestMergeable(func() *heap.PairingHeap[int] {
+//		return heap.NewPairing(cmp.Compare[int])
+//	})
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//
+// The queues under test must order ints ascending, like cmp.Compare. The operations are
+// drawn from a fixed seed, so a failure is reproducible.
+package heaptest
+
+import (
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+
+	"github.com/dev-crusader/data-structures-and-algorithms/datastructure/heap"
+)
+
+const (
+	operations = 2000
+	valueRange = 1000
+)
+
+// oracle is a sorted multiset of the values expected in a queue.
+type oracle []int
+
+func (o *oracle) add(v int) {
+	i, _ := slices.BinarySearch(*o, v)
+	*o = slices.Insert(*o, i, v)
+}
+
+func (o *oracle) remove(v int) {
+	i, _ := slices.BinarySearch(*o, v)
+	*o = slices.Delete(*o, i, i+1)
+}
+
+// TestPriorityQueue runs a random mix of Push, Pop and Peek against the empty queue returned
+// by newQueue and reports the first result differing from a sorted slice. The queue is
+// drained and left empty when the check succeeds.
+func TestPriorityQueue[Q heap.PriorityQueue[int]](newQueue func() Q) error {
+	q := newQueue()
+	if err := checkEmpty(q); err != nil {
+		return err
+	}
+	rng := rand.New(rand.NewPCG(1, 2))
+	var want oracle
+	for i := 0; i < operations; i++ {
+		// push twice as often as pop so the queue grows
+		if rng.IntN(3) > 0 {
+			v := rng.IntN(valueRange)
+			q.Push(v)
+			want.add(v)
+		} else if err := checkPop(q, &want); err != nil {
+			return err
+		}
+		if err := checkTop(q, want); err != nil {
+			return err
+		}
+	}
+	for len(want) > 0 {
+		if err := checkPop(q, &want); err != nil {
+			return err
+		}
+	}
+	return checkEmpty(q)
+}
+
+// TestMergeable runs TestPriorityQueue and then exercises the handles and Meld of the queues
+// returned by newQueue: values are inserted into two queues, decreased, deleted and popped
+// through their handles, the queues are melded and the result is drained in order.
+func TestMergeable[Q heap.MergeablePriorityQueue[int, Q, H], H heap.Handle[int]](newQueue func() Q) error {
+	if err := TestPriorityQueue(newQueue); err != nil {
+		return err
+	}
+
+	rng := rand.New(rand.NewPCG(3, 4))
+	a, b := newQueue(), newQueue()
+	if err := a.Meld(a); !errors.Is(err, heap.ErrSameHeap) {
+		return fmt.Errorf("heaptest: Meld with itself = %v, want %v", err, heap.ErrSameHeap)
+	}
+
+	// the values are kept unique, so a popped value tells which handle went with it
+	used := make(map[int]bool)
+	unique := func(upper int) int {
+		v := upper - 1 - rng.IntN(valueRange)
+		for used[v] {
+			v--
+		}
+		used[v] = true
+		return v
+	}
+
+	// index 0 holds the expected values and handles of a, index 1 the ones of b
+	queues := [2]Q{a, b}
+	var want [2]oracle
+	var live [2][]H
+	var dead []H
+	for i := 0; i < operations; i++ {
+		side := i % 2
+		v := unique(valueRange * operations)
+		live[side] = append(live[side], queues[side].Insert(v))
+		want[side].add(v)
+	}
+
+	// decrease, delete and pop, so the handles of values deep inside the queues are used too
+	for i := 0; i < operations; i++ {
+		side := rng.IntN(2)
+		q, handles := queues[side], live[side]
+		j := rng.IntN(len(handles))
+		h := handles[j]
+		switch rng.IntN(3) {
+		case 0:
+			old := h.Value()
+			v := unique(old)
+			if err := q.DecreaseKey(h, v); err != nil {
+				return fmt.Errorf("heaptest: DecreaseKey(%d to %d) = %v", old, v, err)
+			}
+			if got := h.Value(); got != v {
+				return fmt.Errorf("heaptest: Value() = %d after DecreaseKey to %d", got, v)
+			}
+			want[side].remove(old)
+			want[side].add(v)
+			if err := q.DecreaseKey(h, v+1); !errors.Is(err, heap.ErrInvalidKey) {
+				return fmt.Errorf("heaptest: DecreaseKey(%d to %d) = %v, want %v", v, v+1, err, heap.ErrInvalidKey)
+			}
+		case 1:
+			if err := q.Delete(h); err != nil {
+				return fmt.Errorf("heaptest: Delete(%d) = %v", h.Value(), err)
+			}
+			want[side].remove(h.Value())
+		case 2:
+			v := want[side][0]
+			if err := checkPop(q, &want[side]); err != nil {
+				return err
+			}
+			if j = slices.IndexFunc(handles, func(h H) bool { return h.Value() == v }); j < 0 {
+				return fmt.Errorf("heaptest: no handle holds the popped value %d", v)
+			}
+			h = handles[j]
+		}
+		if _, ok := slices.BinarySearch(want[side], h.Value()); !ok {
+			// the value of the handle left the queue
+			dead = append(dead, h)
+			handles[j] = handles[len(handles)-1]
+			live[side] = handles[:len(handles)-1]
+		}
+		if err := checkTop(q, want[side]); err != nil {
+			return err
+		}
+		if len(live[side]) == 0 {
+			break
+		}
+	}
+
+	for _, h := range dead {
+		if err := a.Delete(h); !errors.Is(err, heap.ErrForeignHandle) {
+			return fmt.Errorf("heaptest: Delete of a removed handle = %v, want %v", err, heap.ErrForeignHandle)
+		}
+	}
+
+	if err := a.Meld(b); err != nil {
+		return fmt.Errorf("heaptest: Meld = %v", err)
+	}
+	if err := checkEmpty(b); err != nil {
+		return fmt.Errorf("%w after Meld", err)
+	}
+	for _, v := range want[1] {
+		want[0].add(v)
+	}
+	if err := checkTop(a, want[0]); err != nil {
+		return fmt.Errorf("%w after Meld", err)
+	}
+	// the handles of b now belong to a
+	for _, h := range live[1] {
+		v := h.Value()
+		if err := a.DecreaseKey(h, v); err != nil {
+			return fmt.Errorf("heaptest: DecreaseKey(%d) after Meld = %v", v, err)
+		}
+		if err := b.DecreaseKey(h, v); !errors.Is(err, heap.ErrForeignHandle) {
+			return fmt.Errorf("heaptest: DecreaseKey on the melded queue = %v, want %v", err, heap.ErrForeignHandle)
+		}
+	}
+	for len(want[0]) > 0 {
+		if err := checkPop(a, &want[0]); err != nil {
+			return err
+		}
+	}
+	return checkEmpty(a)
+}
+
+func checkTop[Q heap.PriorityQueue[int]](q Q, want oracle) error {
+	if n := q.Len(); n != len(want) {
+		return fmt.Errorf("heaptest: Len() = %d, want %d", n, len(want))
+	}
+	if len(want) == 0 {
+		return nil
+	}
+	if v, ok := q.Peek(); !ok || v != want[0] {
+		return fmt.Errorf("heaptest: Peek() = %d, %t; want %d, true", v, ok, want[0])
+	}
+	return nil
+}
+
+func checkPop[Q heap.PriorityQueue[int]](q Q, want *oracle) error {
+	if len(*want) == 0 {
+		return checkEmpty(q)
+	}
+	v, ok := q.Pop()
+	if !ok || v != (*want)[0] {
+		return fmt.Errorf("heaptest: Pop() = %d, %t; want %d, true", v, ok, (*want)[0])
+	}
+	*want = (*want)[1:]
+	return nil
+}
+
+func checkEmpty[Q heap.PriorityQueue[int]](q Q) error {
+	if n := q.Len(); n != 0 {
+		return fmt.Errorf("heaptest: expected an empty queue, Len() = %d", n)
+	}
+	if v, ok := q.Peek(); ok {
+		return fmt.Errorf("heaptest: Peek() on an empty queue = %d", v)
+	}
+	if v, ok := q.Pop(); ok {
+		return fmt.Errorf("heaptest: Pop() on an empty queue = %d", v)
+	}
+	return nil
+}
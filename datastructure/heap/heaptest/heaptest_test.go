@@ -0,0 +1,33 @@
+package heaptest
+
+import "testing"
+
+// stack pops the last value pushed instead of the smallest one.
+type stack struct {
+	items []int
+}
+
+func (s *stack) Push(v int) { s.items = append(s.items, v) }
+
+func (s *stack) Pop() (int, bool) {
+	v, ok := s.Peek()
+	if ok {
+		s.items = s.items[:len(s.items)-1]
+	}
+	return v, ok
+}
+
+func (s *stack) Peek() (int, bool) {
+	if len(s.items) == 0 {
+		return 0, false
+	}
+	return s.items[len(s.items)-1], true
+}
+
+func (s *stack) Len() int { return len(s.items) }
+
+func TestPriorityQueueDetectsWrongOrder(t *testing.T) {
+	if err := TestPriorityQueue(func() *stack { return &stack{} }); err == nil {
+		t.Error("TestPriorityQueue accepted a stack")
+	}
+}
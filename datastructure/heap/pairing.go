@@ -0,0 +1,173 @@
+package heap
+
+import "fmt"
+
+// PairingNode is the handle of a value stored in a PairingHeap.
+type PairingNode[T any] struct {
+	value T
+	child *PairingNode[T]
+	next  *PairingNode[T]
+	prev  *PairingNode[T] // previous sibling, or the parent for the first child
+	owner *owner
+}
+
+// Value returns the value of the node.
+func (n *PairingNode[T]) Value() T {
+	return n.value
+}
+
+// PairingHeap is a heap ordered multiway tree. Push and Meld link a tree under the root in
+// O(1), and Pop repairs the tree by melding the children of the root in pairs.
+type PairingHeap[T any] struct {
+	root  *PairingNode[T]
+	size  int
+	cmp   func(a, b T) int
+	owner *owner
+}
+
+// NewPairing creates an empty pairing heap ordered by cmp as described at New.
+func NewPairing[T any](cmp func(a, b T) int) *PairingHeap[T] {
+	return &PairingHeap[T]{cmp: cmp, owner: &owner{}}
+}
+
+// link makes the root coming later the first child of the other one and returns the new root.
+// Both a and b must be roots without siblings, either may be nil.
+func (h *PairingHeap[T]) link(a, b *PairingNode[T]) *PairingNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if h.cmp(b.value, a.value) < 0 {
+		a, b = b, a
+	}
+	b.next = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+	b.prev = a
+	a.child = b
+	return a
+}
+
+// mergePairs melds a list of siblings into a single tree: first left to right in pairs,
+// then the pairs right to left.
+func (h *PairingHeap[T]) mergePairs(first *PairingNode[T]) *PairingNode[T] {
+	var pairs []*PairingNode[T]
+	for first != nil {
+		a, b := first, first.next
+		if b == nil {
+			first = nil
+		} else {
+			first = b.next
+			b.next, b.prev = nil, nil
+		}
+		a.next, a.prev = nil, nil
+		pairs = append(pairs, h.link(a, b))
+	}
+	var root *PairingNode[T]
+	for i := len(pairs) - 1; i >= 0; i-- {
+		root = h.link(pairs[i], root)
+	}
+	return root
+}
+
+// detach cuts the subtree of n, which must not be the root, from its parent.
+func (h *PairingHeap[T]) detach(n *PairingNode[T]) {
+	if n.prev.child == n {
+		n.prev.child = n.next
+	} else {
+		n.prev.next = n.next
+	}
+	if n.next != nil {
+		n.next.prev = n.prev
+	}
+	n.next, n.prev = nil, nil
+}
+
+// Insert adds v in O(1) and returns its handle.
+func (h *PairingHeap[T]) Insert(v T) *PairingNode[T] {
+	n := &PairingNode[T]{value: v, owner: h.owner}
+	h.root = h.link(h.root, n)
+	h.size++
+	return n
+}
+
+// Push adds v in O(1).
+func (h *PairingHeap[T]) Push(v T) {
+	h.Insert(v)
+}
+
+// Peek returns the first value without removing it.
+func (h *PairingHeap[T]) Peek() (T, bool) {
+	if h.root == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return h.root.value, true
+}
+
+// Pop removes and returns the first value in O(log n) amortized.
+func (h *PairingHeap[T]) Pop() (T, bool) {
+	if h.root == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	n := h.root
+	h.root = h.mergePairs(n.child)
+	h.size--
+	n.child, n.owner = nil, nil
+	return n.value, true
+}
+
+// Len returns the number of values in the heap.
+func (h *PairingHeap[T]) Len() int {
+	return h.size
+}
+
+// DecreaseKey replaces the value of n with v. The subtree of n is cut off and linked with
+// the root again.
+func (h *PairingHeap[T]) DecreaseKey(n *PairingNode[T], v T) error {
+	if !belongsTo(&n.owner, h.owner) {
+		return ErrForeignHandle
+	}
+	if h.cmp(v, n.value) > 0 {
+		return fmt.Errorf("%w: %v after %v", ErrInvalidKey, v, n.value)
+	}
+	n.value = v
+	if n != h.root {
+		h.detach(n)
+		h.root = h.link(h.root, n)
+	}
+	return nil
+}
+
+// Delete removes the value of n in O(log n) amortized.
+func (h *PairingHeap[T]) Delete(n *PairingNode[T]) error {
+	if !belongsTo(&n.owner, h.owner) {
+		return ErrForeignHandle
+	}
+	if n == h.root {
+		h.Pop()
+		return nil
+	}
+	h.detach(n)
+	h.root = h.link(h.root, h.mergePairs(n.child))
+	h.size--
+	n.child, n.owner = nil, nil
+	return nil
+}
+
+// Meld moves all values of other into h in O(1). Both heaps must use the same order.
+func (h *PairingHeap[T]) Meld(other *PairingHeap[T]) error {
+	if h == other {
+		return ErrSameHeap
+	}
+	h.root = h.link(h.root, other.root)
+	h.size += other.size
+	other.owner.forward = h.owner
+	other.owner = &owner{}
+	other.root, other.size = nil, 0
+	return nil
+}
@@ -0,0 +1,110 @@
+package heap
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+)
+
+/*
+	Besides the binary Heap, the package offers three heaps for picking the best fit by benchmark:
+
+	1. DaryHeap      - an implicit heap with d children per node, shallower than a binary heap,
+	                   so it trades cheaper Push and DecreaseKey for more comparisons per Pop
+	2. PairingHeap   - a heap ordered multiway tree, O(1) Push and Meld, O(log n) amortized Pop
+	3. FibonacciHeap - a lazy forest of heap ordered trees, O(1) amortized Push, Meld and
+	                   DecreaseKey, O(log n) amortized Pop
+
+	All of them are MergeablePriorityQueues: Insert returns a handle to the stored value which
+	DecreaseKey and Delete take, and Meld moves all values of another heap of the same kind into
+	the receiver. Handles stay valid across a Meld until their value is popped or deleted.
+*/
+
+var (
+	// ErrInvalidKey is returned by DecreaseKey when the new value would come after the current one.
+	ErrInvalidKey = errors.New("heap: new value comes after the current value")
+	// ErrForeignHandle is returned for handles whose value is not stored in the heap, either
+	// because it belongs to another heap or because it was popped or deleted already.
+	ErrForeignHandle = errors.New("heap: handle does not belong to the heap")
+	// ErrSameHeap is returned when melding a heap into itself.
+	ErrSameHeap = errors.New("heap: cannot meld a heap into itself")
+)
+
+// PriorityQueue is the common set of operations of the heaps in this package, in the order
+// given by their comparison function.
+type PriorityQueue[T any] interface {
+	// Push adds a value.
+	Push(v T)
+	// Pop removes and returns the first value.
+	Pop() (T, bool)
+	// Peek returns the first value without removing it.
+	Peek() (T, bool)
+	// Len returns the number of values.
+	Len() int
+}
+
+// Handle refers to a value stored in a MergeablePriorityQueue.
+type Handle[T any] interface {
+	// Value returns the value the handle refers to.
+	Value() T
+}
+
+// MergeablePriorityQueue is a PriorityQueue which can absorb another queue Q of its own kind
+// and hands out handles H to update or delete the values it stores.
+type MergeablePriorityQueue[T any, Q any, H Handle[T]] interface {
+	PriorityQueue[T]
+	// Insert adds a value and returns its handle.
+	Insert(v T) H
+	// DecreaseKey replaces the value of the handle with v, which must not come after it.
+	DecreaseKey(h H, v T) error
+	// Delete removes the value of the handle.
+	Delete(h H) error
+	// Meld moves all values of other into the queue, leaving other empty.
+	Meld(other Q) error
+}
+
+var (
+	_ PriorityQueue[int]                                                    = (*Heap[int])(nil)
+	_ MergeablePriorityQueue[int, *DaryHeap[int], *DaryElement[int]]        = (*DaryHeap[int])(nil)
+	_ MergeablePriorityQueue[int, *PairingHeap[int], *PairingNode[int]]     = (*PairingHeap[int])(nil)
+	_ MergeablePriorityQueue[int, *FibonacciHeap[int], *FibonacciNode[int]] = (*FibonacciHeap[int])(nil)
+)
+
+// owner identifies the heap a handle belongs to. Melding a heap forwards its owner to the
+// owner of the receiving heap, so the handles of the melded values don't have to be visited
+// and Meld can stay O(1).
+type owner struct {
+	forward *owner
+}
+
+// belongsTo reports whether the handle owner *o resolves to heap. It caches the resolved
+// owner in *o, which keeps the forwarding chains short.
+func belongsTo(o **owner, heap *owner) bool {
+	if *o == nil {
+		return false
+	}
+	for (*o).forward != nil {
+		*o = (*o).forward
+	}
+	return *o == heap
+}
+
+func RunPriorityQueues() {
+	a, b := NewFibonacci(cmp.Compare[int]), NewFibonacci(cmp.Compare[int])
+	for _, v := range []int{40, 10, 30} {
+		a.Push(v)
+	}
+	handle := b.Insert(50)
+	b.Push(20)
+	fmt.Println(a.Pop())
+
+	fmt.Println(a.Meld(b), a.Len(), b.Len())
+	fmt.Println(a.DecreaseKey(handle, 5), a.DecreaseKey(handle, 60))
+	fmt.Println(b.Delete(handle))
+
+	for _, q := range []PriorityQueue[int]{NewMin[int](), NewDary(4, cmp.Compare[int]), NewPairing(cmp.Compare[int]), a} {
+		q.Push(1)
+		v, _ := q.Peek()
+		fmt.Printf("%T: %d values, first %d\n", q, q.Len(), v)
+	}
+}
@@ -0,0 +1,56 @@
+package heap_test
+
+import (
+	"cmp"
+	"fmt"
+	"testing"
+
+	"github.com/dev-crusader/data-structures-and-algorithms/datastructure/heap"
+	"github.com/dev-crusader/data-structures-and-algorithms/datastructure/heap/heaptest"
+)
+
+func TestHeap(t *testing.T) {
+	if err := heaptest.TestPriorityQueue(heap.NewMin[int]); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDaryHeap(t *testing.T) {
+	for _, d := range []int{2, 3, 4, 8} {
+		t.Run(fmt.Sprintf("d=%d", d), func(t *testing.T) {
+			newQueue := func() *heap.DaryHeap[int] {
+				return heap.NewDary(d, cmp.Compare[int])
+			}
+			if err := heaptest.TestPriorityQueue(newQueue); err != nil {
+				t.Error(err)
+			}
+			if err := heaptest.TestMergeable(newQueue); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestPairingHeap(t *testing.T) {
+	newQueue := func() *heap.PairingHeap[int] {
+		return heap.NewPairing(cmp.Compare[int])
+	}
+	if err := heaptest.TestPriorityQueue(newQueue); err != nil {
+		t.Error(err)
+	}
+	if err := heaptest.TestMergeable(newQueue); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestFibonacciHeap(t *testing.T) {
+	newQueue := func() *heap.FibonacciHeap[int] {
+		return heap.NewFibonacci(cmp.Compare[int])
+	}
+	if err := heaptest.TestPriorityQueue(newQueue); err != nil {
+		t.Error(err)
+	}
+	if err := heaptest.TestMergeable(newQueue); err != nil {
+		t.Error(err)
+	}
+}
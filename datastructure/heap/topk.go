@@ -0,0 +1,200 @@
+package heap
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"slices"
+	"time"
+)
+
+/*
+	Streaming selection on top of Heap:
+
+	1. TopK          - keeps the k largest values seen in a min-heap of size k, so the smallest
+	                   kept value is the one to drop when a larger value arrives
+	2. BottomK       - the same with the order reversed, keeping the k smallest values
+	3. RunningMedian - keeps the lower half of the values in a max-heap and the upper half in a
+	                   min-heap, so the middle values are always at the tops
+
+	Values are added one by one, from an iter.Seq or from a channel until it is closed. Memory is
+	O(k) for TopK and BottomK and O(n) for RunningMedian, each value costs O(log k) or O(log n).
+*/
+
+// boundedHeap keeps the k values coming last in the heap order.
+type boundedHeap[T any] struct {
+	k    int
+	heap *Heap[T]
+}
+
+// Add offers v in O(log k), it is kept if it is among the k best values so far.
+func (b *boundedHeap[T]) Add(v T) {
+	if b.k <= 0 {
+		return
+	}
+	if b.heap.Len() < b.k {
+		b.heap.Push(v)
+		return
+	}
+	// replace the top in place instead of a Pop followed by a Push
+	if b.heap.cmp(v, b.heap.items[0]) > 0 {
+		b.heap.items[0] = v
+		b.heap.down(0, b.heap.Len())
+	}
+}
+
+// AddSeq offers all values of seq.
+func (b *boundedHeap[T]) AddSeq(seq iter.Seq[T]) {
+	for v := range seq {
+		b.Add(v)
+	}
+}
+
+// AddChan offers the values received from ch until it is closed.
+func (b *boundedHeap[T]) AddChan(ch <-chan T) {
+	for v := range ch {
+		b.Add(v)
+	}
+}
+
+// Len returns the number of retained values, at most k.
+func (b *boundedHeap[T]) Len() int {
+	return b.heap.Len()
+}
+
+// values returns the kept values, the last one in heap order first.
+func (b *boundedHeap[T]) values() []T {
+	return b.heap.clone().HeapSort()
+}
+
+// TopK retains the k largest values added to it.
+type TopK[T any] struct {
+	boundedHeap[T]
+}
+
+// NewTopK creates a TopK for the k largest values in the ascending order given by cmp.
+func NewTopK[T any](k int, cmp func(a, b T) int) *TopK[T] {
+	return &TopK[T]{boundedHeap[T]{k: k, heap: New(cmp)}}
+}
+
+// Values returns the retained values, the largest first.
+func (t *TopK[T]) Values() []T {
+	return t.values()
+}
+
+// BottomK retains the k smallest values added to it.
+type BottomK[T any] struct {
+	boundedHeap[T]
+}
+
+// NewBottomK creates a BottomK for the k smallest values in the ascending order given by cmp.
+func NewBottomK[T any](k int, cmp func(a, b T) int) *BottomK[T] {
+	return &BottomK[T]{boundedHeap[T]{k: k, heap: New(func(a, b T) int {
+		return cmp(b, a)
+	})}}
+}
+
+// Values returns the retained values, the smallest first.
+func (b *BottomK[T]) Values() []T {
+	return b.values()
+}
+
+// Number is the set of types RunningMedian can average.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// RunningMedian tracks the median of a stream of numbers.
+type RunningMedian[T Number] struct {
+	lower *Heap[T] // max-heap holding the lower half, one value more for an odd count
+	upper *Heap[T] // min-heap holding the upper half
+}
+
+// NewRunningMedian creates a RunningMedian without any values.
+func NewRunningMedian[T Number]() *RunningMedian[T] {
+	return &RunningMedian[T]{lower: NewMax[T](), upper: NewMin[T]()}
+}
+
+// Add adds v in O(log n).
+func (m *RunningMedian[T]) Add(v T) {
+	if top, ok := m.lower.Peek(); !ok || v <= top {
+		m.lower.Push(v)
+	} else {
+		m.upper.Push(v)
+	}
+	// rebalance, so that 0 <= lower.Len() - upper.Len() <= 1
+	if m.lower.Len() > m.upper.Len()+1 {
+		v, _ := m.lower.Pop()
+		m.upper.Push(v)
+	} else if m.upper.Len() > m.lower.Len() {
+		v, _ := m.upper.Pop()
+		m.lower.Push(v)
+	}
+}
+
+// AddSeq adds all values of seq.
+func (m *RunningMedian[T]) AddSeq(seq iter.Seq[T]) {
+	for v := range seq {
+		m.Add(v)
+	}
+}
+
+// AddChan adds the values received from ch until it is closed.
+func (m *RunningMedian[T]) AddChan(ch <-chan T) {
+	for v := range ch {
+		m.Add(v)
+	}
+}
+
+// Len returns the number of values added.
+func (m *RunningMedian[T]) Len() int {
+	return m.lower.Len() + m.upper.Len()
+}
+
+// Middle returns the middle values in sorted order, which are the same value for an odd count.
+func (m *RunningMedian[T]) Middle() (T, T, bool) {
+	lo, ok := m.lower.Peek()
+	if !ok {
+		return lo, lo, false
+	}
+	if m.lower.Len() > m.upper.Len() {
+		return lo, lo, true
+	}
+	hi, _ := m.upper.Peek()
+	return lo, hi, true
+}
+
+// Median returns the median, the mean of the two middle values for an even count.
+func (m *RunningMedian[T]) Median() (float64, bool) {
+	lo, hi, ok := m.Middle()
+	if !ok {
+		return 0, false
+	}
+	return (float64(lo) + float64(hi)) / 2, true
+}
+
+func RunTopK() {
+	scores := make(chan int)
+	go func() {
+		for _, v := range []int{52, 97, 13, 88, 61, 97, 4, 75, 30} {
+			scores <- v
+		}
+		close(scores)
+	}()
+	top := NewTopK(3, cmp.Compare[int])
+	top.AddChan(scores)
+	fmt.Println("top 3:", top.Values())
+
+	bottom := NewBottomK(3, cmp.Compare[string])
+	bottom.AddSeq(slices.Values([]string{"pear", "fig", "apple", "kiwi", "banana"}))
+	fmt.Println("bottom 3:", bottom.Values())
+
+	median := NewRunningMedian[time.Duration]()
+	for _, ms := range []int{120, 35, 80, 42, 300} {
+		median.Add(time.Duration(ms) * time.Millisecond)
+		m, _ := median.Median()
+		fmt.Println("median latency:", time.Duration(m))
+	}
+}
@@ -0,0 +1,131 @@
+package heap_test
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/dev-crusader/data-structures-and-algorithms/datastructure/heap"
+)
+
+// randomValues returns n values drawn from a small range, so duplicates are common.
+func randomValues(seed uint64, n int) []int {
+	r := rand.New(rand.NewPCG(seed, seed))
+	values := make([]int, n)
+	for i := range values {
+		values[i] = r.IntN(50) - 25
+	}
+	return values
+}
+
+// wantTop returns the k largest of values, the largest first.
+func wantTop(values []int, k int) []int {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	slices.Reverse(sorted)
+	return sorted[:min(max(k, 0), len(sorted))]
+}
+
+// wantBottom returns the k smallest of values, the smallest first.
+func wantBottom(values []int, k int) []int {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	return sorted[:min(max(k, 0), len(sorted))]
+}
+
+func TestTopK(t *testing.T) {
+	for _, k := range []int{-1, 0, 1, 5, 200} {
+		t.Run(fmt.Sprintf("k=%d", k), func(t *testing.T) {
+			values := randomValues(uint64(k+2), 100)
+			top := heap.NewTopK(k, cmp.Compare[int])
+			bottom := heap.NewBottomK(k, cmp.Compare[int])
+			for i, v := range values {
+				top.Add(v)
+				bottom.Add(v)
+				if got, want := top.Values(), wantTop(values[:i+1], k); !slices.Equal(got, want) {
+					t.Fatalf("TopK after %d values = %v, want %v", i+1, got, want)
+				}
+				if got, want := bottom.Values(), wantBottom(values[:i+1], k); !slices.Equal(got, want) {
+					t.Fatalf("BottomK after %d values = %v, want %v", i+1, got, want)
+				}
+				if n := min(max(k, 0), i+1); top.Len() != n || bottom.Len() != n {
+					t.Fatalf("Len() = %d and %d after %d values, want %d", top.Len(), bottom.Len(), i+1, n)
+				}
+			}
+		})
+	}
+}
+
+func TestTopKAddSeqAndChan(t *testing.T) {
+	values := randomValues(7, 1000)
+	const k = 10
+
+	top := heap.NewTopK(k, cmp.Compare[int])
+	top.AddSeq(slices.Values(values))
+	if got, want := top.Values(), wantTop(values, k); !slices.Equal(got, want) {
+		t.Errorf("TopK.AddSeq = %v, want %v", got, want)
+	}
+
+	bottom := heap.NewBottomK(k, cmp.Compare[int])
+	ch := make(chan int)
+	go func() {
+		for _, v := range values {
+			ch <- v
+		}
+		close(ch)
+	}()
+	bottom.AddChan(ch)
+	if got, want := bottom.Values(), wantBottom(values, k); !slices.Equal(got, want) {
+		t.Errorf("BottomK.AddChan = %v, want %v", got, want)
+	}
+}
+
+// wantMedian returns the median of values using a sorted copy.
+func wantMedian(values []int) float64 {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	n := len(sorted)
+	return (float64(sorted[(n-1)/2]) + float64(sorted[n/2])) / 2
+}
+
+func TestRunningMedian(t *testing.T) {
+	m := heap.NewRunningMedian[int]()
+	if _, ok := m.Median(); ok {
+		t.Fatal("Median() of no values reported ok")
+	}
+	values := randomValues(3, 500)
+	for i, v := range values {
+		m.Add(v)
+		got, ok := m.Median()
+		if want := wantMedian(values[:i+1]); !ok || got != want {
+			t.Fatalf("Median() after %d values = %v, %t; want %v, true", i+1, got, ok, want)
+		}
+		if m.Len() != i+1 {
+			t.Fatalf("Len() = %d, want %d", m.Len(), i+1)
+		}
+	}
+}
+
+func TestRunningMedianAddSeqAndChan(t *testing.T) {
+	values := randomValues(11, 999)
+	want := wantMedian(values)
+
+	m := heap.NewRunningMedian[int]()
+	m.AddSeq(slices.Values(values))
+	if got, _ := m.Median(); got != want {
+		t.Errorf("Median() after AddSeq = %v, want %v", got, want)
+	}
+
+	m = heap.NewRunningMedian[int]()
+	ch := make(chan int, len(values))
+	for _, v := range values {
+		ch <- v
+	}
+	close(ch)
+	m.AddChan(ch)
+	if got, _ := m.Median(); got != want {
+		t.Errorf("Median() after AddChan = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,205 @@
+/* Classic linked list algorithms on the doubly linked Queue.
+The Queue is read from head to tail, the same order GetAll returns.
+Nodes are relinked in place, after which the prev pointers and the tail are restored.
+*/
+
+package linkedlist
+
+import "fmt"
+
+// HasCycle reports whether following the next pointers from the head loops back on itself,
+// using Floyd's tortoise and hare. A Queue only built through its methods never has one.
+func (a *Queue) HasCycle() bool {
+	_, ok := a.CycleStart()
+	return ok
+}
+
+// CycleStart returns the value of the first node of the cycle reached from the head, and false
+// if the next pointers lead to the end of the queue. Once the hare meets the tortoise inside
+// the cycle, a pointer restarted from the head and the tortoise reach the cycle start after
+// the same number of steps.
+func (a *Queue) CycleStart() (int, bool) {
+	slow, fast := a.head, a.head
+	for fast != nil && fast.next != nil {
+		slow = slow.next
+		fast = fast.next.next
+		if slow == fast {
+			for start := a.head; start != slow; start, slow = start.next, slow.next {
+			}
+			return slow.value, true
+		}
+	}
+	return -1, false
+}
+
+// Middle returns the middle value of the queue. For an even length the second of the two
+// middle values is returned.
+func (a *Queue) Middle() (int, bool) {
+	if a.isEmpty() {
+		return -1, false
+	}
+	slow, fast := a.head, a.head
+	for fast != nil && fast.next != nil {
+		slow = slow.next
+		fast = fast.next.next
+	}
+	return slow.value, true
+}
+
+// RemoveNthFromEnd removes and returns the nth value counted from the tail, n = 1 being the tail.
+func (a *Queue) RemoveNthFromEnd(n int) (int, bool) {
+	if n < 1 || n > a.size {
+		return -1, false
+	}
+	// the queue is doubly linked, so walk back from the tail
+	node := a.tail
+	for i := 1; i < n; i++ {
+		node = node.prev
+	}
+	if node.prev == nil {
+		a.head = node.next
+	} else {
+		node.prev.next = node.next
+	}
+	if node.next == nil {
+		a.tail = node.prev
+	} else {
+		node.next.prev = node.prev
+	}
+	node.next, node.prev = nil, nil
+	a.size--
+	return node.value, true
+}
+
+// Reverse reverses the queue in place.
+func (a *Queue) Reverse() {
+	for node := a.head; node != nil; node = node.prev {
+		node.next, node.prev = node.prev, node.next
+	}
+	a.head, a.tail = a.tail, a.head
+}
+
+// ReverseInGroups reverses the order of every group of k consecutive values in place.
+// A trailing group shorter than k keeps its order.
+func (a *Queue) ReverseInGroups(k int) {
+	if k < 2 {
+		return
+	}
+	dummy := &Node{next: a.head}
+	groupPrev := dummy
+	for {
+		groupEnd := groupPrev
+		for i := 0; i < k && groupEnd != nil; i++ {
+			groupEnd = groupEnd.next
+		}
+		if groupEnd == nil {
+			break
+		}
+		groupStart, nextGroup := groupPrev.next, groupEnd.next
+		prev, current := nextGroup, groupStart
+		for current != nextGroup {
+			current.next, prev, current = prev, current, current.next
+		}
+		groupPrev.next = groupEnd
+		groupPrev = groupStart
+	}
+	a.head = dummy.next
+	a.relinkPrev()
+}
+
+// relinkPrev restores the prev pointers and the tail from the next pointers.
+func (a *Queue) relinkPrev() {
+	var prev *Node
+	for node := a.head; node != nil; node = node.next {
+		node.prev = prev
+		prev = node
+	}
+	a.tail = prev
+}
+
+// MergeSortedQueues merges two queues sorted in ascending order from head to tail into a new
+// sorted queue. The nodes of a and b are spliced into the result, so both are left empty.
+func MergeSortedQueues(a, b *Queue) *Queue {
+	result := &Queue{size: a.size + b.size}
+	dummy := &Node{}
+	last := dummy
+	x, y := a.head, b.head
+	for x != nil && y != nil {
+		if y.value < x.value {
+			last.next, y = y, y.next
+		} else {
+			last.next, x = x, x.next
+		}
+		last = last.next
+	}
+	if x != nil {
+		last.next = x
+	} else {
+		last.next = y
+	}
+	result.head = dummy.next
+	result.relinkPrev()
+	*a, *b = Queue{}, Queue{}
+	return result
+}
+
+// MergeKSortedQueues merges any number of queues sorted in ascending order from head to tail
+// into a new sorted queue by merging them in pairs, which takes O(n log k). The input queues
+// are left empty.
+func MergeKSortedQueues(queues ...*Queue) *Queue {
+	if len(queues) == 0 {
+		return &Queue{}
+	}
+	for len(queues) > 1 {
+		merged := make([]*Queue, 0, (len(queues)+1)/2)
+		for i := 0; i+1 < len(queues); i += 2 {
+			merged = append(merged, MergeSortedQueues(queues[i], queues[i+1]))
+		}
+		if len(queues)%2 == 1 {
+			merged = append(merged, queues[len(queues)-1])
+		}
+		queues = merged
+	}
+	return queues[0]
+}
+
+func RunQueueAlgorithms() {
+	q := Queue{}
+	for _, v := range []int{1, 2, 3, 4, 5, 6, 7} {
+		q.AddToEnd(v)
+	}
+	fmt.Println(q.Middle())
+	q.ReverseInGroups(2)
+	fmt.Println("reversed in groups of 2:", q.GetAll())
+	fmt.Println(q.RemoveNthFromEnd(3))
+	q.Reverse()
+	fmt.Println("reversed:", q.GetAll(), "has cycle:", q.HasCycle())
+
+	a, b := &Queue{}, &Queue{}
+	for _, v := range []int{1, 3, 5} {
+		a.AddToEnd(v)
+	}
+	for _, v := range []int{2, 4, 6, 8} {
+		b.AddToEnd(v)
+	}
+	merged := MergeSortedQueues(a, b)
+	fmt.Println("merged:", merged.GetAll(), "size:", merged.Len())
+	fmt.Println(merged.Pop())
+	fmt.Println(merged.RemoveFromFront())
+
+	c, d, e := &Queue{}, &Queue{}, &Queue{}
+	for _, v := range []int{0, 9} {
+		c.AddToEnd(v)
+	}
+	for _, v := range []int{3, 4} {
+		d.AddToEnd(v)
+	}
+	for _, v := range []int{1, 7} {
+		e.AddToEnd(v)
+	}
+	fmt.Println("merged k:", MergeKSortedQueues(c, d, e).GetAll())
+
+	// Link the tail back to the third node to form a cycle.
+	merged.tail.next = merged.head.next.next
+	fmt.Println(merged.CycleStart())
+}
@@ -0,0 +1,218 @@
+package linkedlist
+
+import (
+	"slices"
+	"testing"
+)
+
+func queueOf(values ...int) *Queue {
+	q := &Queue{}
+	for _, v := range values {
+		q.AddToEnd(v)
+	}
+	return q
+}
+
+// checkLinks verifies that the prev pointers, the tail and the size agree with the next pointers.
+func checkLinks(t *testing.T, q *Queue) {
+	t.Helper()
+	var prev *Node
+	n := 0
+	for node := q.head; node != nil; node = node.next {
+		if node.prev != prev {
+			t.Fatalf("node %d: prev pointer does not lead back to the previous node", n)
+		}
+		prev = node
+		n++
+	}
+	if q.tail != prev {
+		t.Fatal("tail is not the last node")
+	}
+	if q.size != n {
+		t.Fatalf("size %d, %d nodes linked", q.size, n)
+	}
+}
+
+func TestQueueCycle(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		loopTo int // index of the node the tail links back to, -1 for no cycle
+	}{
+		{"empty", nil, -1},
+		{"single", []int{1}, -1},
+		{"no cycle", []int{1, 2, 3, 4, 5}, -1},
+		{"self loop", []int{1}, 0},
+		{"back to head", []int{1, 2, 3, 4}, 0},
+		{"back to middle", []int{1, 2, 3, 4, 5}, 2},
+		{"back to tail", []int{1, 2, 3, 4, 5}, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := queueOf(tt.values...)
+			if tt.loopTo >= 0 {
+				target := q.head
+				for range tt.loopTo {
+					target = target.next
+				}
+				q.tail.next = target
+			}
+			if got := q.HasCycle(); got != (tt.loopTo >= 0) {
+				t.Errorf("HasCycle() = %t, want %t", got, tt.loopTo >= 0)
+			}
+			v, ok := q.CycleStart()
+			if tt.loopTo < 0 {
+				if ok {
+					t.Errorf("CycleStart() = %d, true; want no cycle", v)
+				}
+			} else if !ok || v != tt.values[tt.loopTo] {
+				t.Errorf("CycleStart() = %d, %t; want %d, true", v, ok, tt.values[tt.loopTo])
+			}
+		})
+	}
+}
+
+func TestQueueMiddle(t *testing.T) {
+	tests := []struct {
+		values []int
+		want   int
+		ok     bool
+	}{
+		{nil, -1, false},
+		{[]int{1}, 1, true},
+		{[]int{1, 2}, 2, true},
+		{[]int{1, 2, 3}, 2, true},
+		{[]int{1, 2, 3, 4}, 3, true},
+		{[]int{1, 2, 3, 4, 5}, 3, true},
+	}
+	for _, tt := range tests {
+		if got, ok := queueOf(tt.values...).Middle(); got != tt.want || ok != tt.ok {
+			t.Errorf("%v.Middle() = %d, %t; want %d, %t", tt.values, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestQueueRemoveNthFromEnd(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		n      int
+		want   int
+		ok     bool
+		rest   []int
+	}{
+		{"tail", []int{1, 2, 3, 4}, 1, 4, true, []int{1, 2, 3}},
+		{"head", []int{1, 2, 3, 4}, 4, 1, true, []int{2, 3, 4}},
+		{"middle", []int{1, 2, 3, 4}, 2, 3, true, []int{1, 2, 4}},
+		{"only value", []int{1}, 1, 1, true, []int{}},
+		{"zero", []int{1, 2, 3}, 0, -1, false, []int{1, 2, 3}},
+		{"past the head", []int{1, 2, 3}, 4, -1, false, []int{1, 2, 3}},
+		{"empty", nil, 1, -1, false, []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := queueOf(tt.values...)
+			got, ok := q.RemoveNthFromEnd(tt.n)
+			if got != tt.want || ok != tt.ok {
+				t.Errorf("RemoveNthFromEnd(%d) = %d, %t; want %d, %t", tt.n, got, ok, tt.want, tt.ok)
+			}
+			if rest := q.GetAll(); !slices.Equal(rest, tt.rest) {
+				t.Errorf("queue %v, want %v", rest, tt.rest)
+			}
+			checkLinks(t, q)
+		})
+	}
+}
+
+func TestQueueReverseInGroups(t *testing.T) {
+	tests := []struct {
+		values []int
+		k      int
+		want   []int
+	}{
+		{nil, 2, []int{}},
+		{[]int{1, 2, 3, 4, 5, 6}, 2, []int{2, 1, 4, 3, 6, 5}},
+		{[]int{1, 2, 3, 4, 5, 6, 7}, 2, []int{2, 1, 4, 3, 6, 5, 7}},
+		{[]int{1, 2, 3, 4, 5, 6, 7, 8}, 3, []int{3, 2, 1, 6, 5, 4, 7, 8}},
+		{[]int{1, 2, 3}, 3, []int{3, 2, 1}},
+		{[]int{1, 2}, 3, []int{1, 2}},
+		{[]int{1, 2, 3}, 1, []int{1, 2, 3}},
+		{[]int{1, 2, 3}, 0, []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		q := queueOf(tt.values...)
+		q.ReverseInGroups(tt.k)
+		if got := q.GetAll(); !slices.Equal(got, tt.want) {
+			t.Errorf("%v.ReverseInGroups(%d) = %v, want %v", tt.values, tt.k, got, tt.want)
+		}
+		checkLinks(t, q)
+	}
+}
+
+func TestQueueReverse(t *testing.T) {
+	for _, values := range [][]int{nil, {1}, {1, 2}, {1, 2, 3, 4, 5}} {
+		q := queueOf(values...)
+		q.Reverse()
+		want := slices.Clone(values)
+		slices.Reverse(want)
+		if got := q.GetAll(); !slices.Equal(got, want) {
+			t.Errorf("%v reversed = %v, want %v", values, got, want)
+		}
+		checkLinks(t, q)
+	}
+}
+
+func TestMergeSortedQueues(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []int
+		want []int
+	}{
+		{"both empty", nil, nil, []int{}},
+		{"first empty", nil, []int{1, 2}, []int{1, 2}},
+		{"second empty", []int{1, 2}, nil, []int{1, 2}},
+		{"interleaved", []int{1, 3, 5}, []int{2, 4, 6, 8}, []int{1, 2, 3, 4, 5, 6, 8}},
+		{"duplicates", []int{1, 2, 2}, []int{2, 3}, []int{1, 2, 2, 2, 3}},
+		{"disjoint", []int{5, 6}, []int{1, 2}, []int{1, 2, 5, 6}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := queueOf(tt.a...), queueOf(tt.b...)
+			merged := MergeSortedQueues(a, b)
+			if got := merged.GetAll(); !slices.Equal(got, tt.want) {
+				t.Errorf("merged %v, want %v", got, tt.want)
+			}
+			checkLinks(t, merged)
+			if a.Len() != 0 || b.Len() != 0 {
+				t.Errorf("inputs left with %d and %d values, want empty", a.Len(), b.Len())
+			}
+		})
+	}
+}
+
+func TestMergeKSortedQueues(t *testing.T) {
+	tests := []struct {
+		name   string
+		queues [][]int
+		want   []int
+	}{
+		{"none", nil, []int{}},
+		{"one", [][]int{{1, 2}}, []int{1, 2}},
+		{"all empty", [][]int{nil, nil, nil}, []int{}},
+		{"some empty", [][]int{nil, {3, 4}, nil, {1, 5}}, []int{1, 3, 4, 5}},
+		{"odd count", [][]int{{1, 4, 7}, {2, 5, 8}, {0, 3, 6, 9}}, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var queues []*Queue
+			for _, values := range tt.queues {
+				queues = append(queues, queueOf(values...))
+			}
+			merged := MergeKSortedQueues(queues...)
+			if got := merged.GetAll(); !slices.Equal(got, tt.want) {
+				t.Errorf("merged %v, want %v", got, tt.want)
+			}
+			checkLinks(t, merged)
+		})
+	}
+}
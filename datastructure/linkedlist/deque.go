@@ -0,0 +1,234 @@
+/* This is a generic implementation of a doubly linkedlist which can hold any type in its nodes.
+Deque has a pointer to both head (front) and tail (back), and its size.
+Elements can be pushed, popped and peeked at both ends. Push methods return a handle to the
+element holding the value which can be used to insert around it, remove it or move it in O(1).
+*/
+
+package linkedlist
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Deque is a generic double-ended queue backed by a doubly linkedlist.
+// The zero value is an empty deque ready to use.
+type Deque[T any] struct {
+	head, tail *Element[T]
+	size       int
+}
+
+// Element is a node of a Deque.
+type Element[T any] struct {
+	next, prev *Element[T]
+	deque      *Deque[T] // nil once the element is removed
+	Value      T
+}
+
+// NewDeque creates a new empty Deque.
+func NewDeque[T any]() *Deque[T] {
+	return &Deque[T]{}
+}
+
+// Next returns the element after e, or nil at the back of the deque.
+func (e *Element[T]) Next() *Element[T] {
+	return e.next
+}
+
+// Prev returns the element before e, or nil at the front of the deque.
+func (e *Element[T]) Prev() *Element[T] {
+	return e.prev
+}
+
+// InsertBefore adds v right before e and returns its element.
+// It returns nil if e is no longer part of a deque.
+func (e *Element[T]) InsertBefore(v T) *Element[T] {
+	if e.deque == nil {
+		return nil
+	}
+	return e.deque.insert(v, e.prev, e)
+}
+
+// InsertAfter adds v right after e and returns its element.
+// It returns nil if e is no longer part of a deque.
+func (e *Element[T]) InsertAfter(v T) *Element[T] {
+	if e.deque == nil {
+		return nil
+	}
+	return e.deque.insert(v, e, e.next)
+}
+
+// Remove unlinks e from its deque and returns its value. Removing twice has no effect.
+func (e *Element[T]) Remove() T {
+	if e.deque != nil {
+		e.deque.unlink(e)
+	}
+	return e.Value
+}
+
+// MoveToFront moves e to the front of its deque.
+func (e *Element[T]) MoveToFront() {
+	d := e.deque
+	if d == nil || d.head == e {
+		return
+	}
+	d.unlink(e)
+	d.link(e, nil, d.head)
+}
+
+// MoveToBack moves e to the back of its deque.
+func (e *Element[T]) MoveToBack() {
+	d := e.deque
+	if d == nil || d.tail == e {
+		return
+	}
+	d.unlink(e)
+	d.link(e, d.tail, nil)
+}
+
+// insert creates an element for v between prev and next, either of which may be nil at the ends.
+func (d *Deque[T]) insert(v T, prev, next *Element[T]) *Element[T] {
+	e := &Element[T]{Value: v}
+	d.link(e, prev, next)
+	return e
+}
+
+func (d *Deque[T]) link(e, prev, next *Element[T]) {
+	e.prev, e.next, e.deque = prev, next, d
+	if prev == nil {
+		d.head = e
+	} else {
+		prev.next = e
+	}
+	if next == nil {
+		d.tail = e
+	} else {
+		next.prev = e
+	}
+	d.size++
+}
+
+func (d *Deque[T]) unlink(e *Element[T]) {
+	if e.prev == nil {
+		d.head = e.next
+	} else {
+		e.prev.next = e.next
+	}
+	if e.next == nil {
+		d.tail = e.prev
+	} else {
+		e.next.prev = e.prev
+	}
+	e.prev, e.next, e.deque = nil, nil, nil
+	d.size--
+}
+
+// PushFront adds v to the front of the deque and returns its element.
+func (d *Deque[T]) PushFront(v T) *Element[T] {
+	return d.insert(v, nil, d.head)
+}
+
+// PushBack adds v to the back of the deque and returns its element.
+func (d *Deque[T]) PushBack(v T) *Element[T] {
+	return d.insert(v, d.tail, nil)
+}
+
+// PopFront removes and returns the value at the front of the deque.
+func (d *Deque[T]) PopFront() (T, bool) {
+	if d.head == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return d.head.Remove(), true
+}
+
+// PopBack removes and returns the value at the back of the deque.
+func (d *Deque[T]) PopBack() (T, bool) {
+	if d.tail == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return d.tail.Remove(), true
+}
+
+// PeekFront returns the value at the front of the deque without removing it.
+func (d *Deque[T]) PeekFront() (T, bool) {
+	if d.head == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return d.head.Value, true
+}
+
+// PeekBack returns the value at the back of the deque without removing it.
+func (d *Deque[T]) PeekBack() (T, bool) {
+	if d.tail == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return d.tail.Value, true
+}
+
+// Front returns the first element of the deque, or nil if it is empty.
+func (d *Deque[T]) Front() *Element[T] {
+	return d.head
+}
+
+// Back returns the last element of the deque, or nil if it is empty.
+func (d *Deque[T]) Back() *Element[T] {
+	return d.tail
+}
+
+// Len returns the number of elements in the deque.
+func (d *Deque[T]) Len() int {
+	return d.size
+}
+
+// GetAll returns the values of the deque from front to back.
+func (d *Deque[T]) GetAll() []T {
+	result := make([]T, 0, d.size)
+	for i := d.head; i != nil; i = i.next {
+		result = append(result, i.Value)
+	}
+	return result
+}
+
+// All returns an iterator over the values of the deque from front to back.
+func (d *Deque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := d.head; i != nil; i = i.next {
+			if !yield(i.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the values of the deque from back to front.
+func (d *Deque[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := d.tail; i != nil; i = i.prev {
+			if !yield(i.Value) {
+				return
+			}
+		}
+	}
+}
+
+func RunDeque() {
+	d := NewDeque[string]()
+	d.PushBack("b")
+	c := d.PushBack("c")
+	d.PushFront("a")
+	c.InsertAfter("e").InsertBefore("d")
+	fmt.Println(d.GetAll(), "Len:", d.Len())
+
+	c.MoveToFront()
+	fmt.Println(d.GetAll())
+	fmt.Println(c.Remove())
+	fmt.Println(d.PeekFront())
+	fmt.Println(d.PeekBack())
+	fmt.Println(d.PopFront())
+	fmt.Println(d.PopBack())
+	fmt.Println(d.GetAll(), "Len:", d.Len())
+}
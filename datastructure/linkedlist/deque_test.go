@@ -0,0 +1,158 @@
+package linkedlist
+
+import (
+	"slices"
+	"testing"
+)
+
+// checkDeque verifies the values of the deque in both directions, its length and the
+// links between its elements.
+func checkDeque(t *testing.T, d *Deque[int], want ...int) {
+	t.Helper()
+	if got := d.GetAll(); !slices.Equal(got, want) {
+		t.Fatalf("GetAll() = %v, want %v", got, want)
+	}
+	backward := slices.Clone(want)
+	slices.Reverse(backward)
+	if got := slices.Collect(d.Backward()); !slices.Equal(got, backward) {
+		t.Fatalf("Backward() = %v, want %v", got, backward)
+	}
+	if d.Len() != len(want) {
+		t.Fatalf("Len() = %d, want %d", d.Len(), len(want))
+	}
+	var prev *Element[int]
+	for e := d.Front(); e != nil; e = e.Next() {
+		if e.Prev() != prev {
+			t.Fatalf("element %d has the wrong previous element", e.Value)
+		}
+		if e.deque != d {
+			t.Fatalf("element %d does not point back to its deque", e.Value)
+		}
+		prev = e
+	}
+	if d.Back() != prev {
+		t.Fatal("Back() is not the last element")
+	}
+}
+
+func TestDequePushPop(t *testing.T) {
+	d := NewDeque[int]()
+	if _, ok := d.PopFront(); ok {
+		t.Error("PopFront() on an empty deque reported a value")
+	}
+	if _, ok := d.PopBack(); ok {
+		t.Error("PopBack() on an empty deque reported a value")
+	}
+	if _, ok := d.PeekFront(); ok {
+		t.Error("PeekFront() on an empty deque reported a value")
+	}
+	if _, ok := d.PeekBack(); ok {
+		t.Error("PeekBack() on an empty deque reported a value")
+	}
+	checkDeque(t, d)
+
+	d.PushBack(2)
+	d.PushFront(1)
+	d.PushBack(3)
+	checkDeque(t, d, 1, 2, 3)
+	if v, ok := d.PeekFront(); !ok || v != 1 {
+		t.Errorf("PeekFront() = %d, %t; want 1, true", v, ok)
+	}
+	if v, ok := d.PeekBack(); !ok || v != 3 {
+		t.Errorf("PeekBack() = %d, %t; want 3, true", v, ok)
+	}
+	if v, ok := d.PopFront(); !ok || v != 1 {
+		t.Errorf("PopFront() = %d, %t; want 1, true", v, ok)
+	}
+	if v, ok := d.PopBack(); !ok || v != 3 {
+		t.Errorf("PopBack() = %d, %t; want 3, true", v, ok)
+	}
+	checkDeque(t, d, 2)
+	d.PopBack()
+	checkDeque(t, d)
+}
+
+func TestDequeInsert(t *testing.T) {
+	d := NewDeque[int]()
+	two := d.PushBack(2)
+	two.InsertBefore(1)
+	checkDeque(t, d, 1, 2)
+	two.InsertAfter(4).InsertBefore(3)
+	checkDeque(t, d, 1, 2, 3, 4)
+	d.Front().InsertBefore(0)
+	d.Back().InsertAfter(5)
+	checkDeque(t, d, 0, 1, 2, 3, 4, 5)
+
+	two.Remove()
+	if e := two.InsertBefore(6); e != nil {
+		t.Error("InsertBefore on a removed element returned an element")
+	}
+	if e := two.InsertAfter(6); e != nil {
+		t.Error("InsertAfter on a removed element returned an element")
+	}
+	checkDeque(t, d, 0, 1, 3, 4, 5)
+}
+
+func TestDequeRemove(t *testing.T) {
+	d := NewDeque[int]()
+	one, two, three := d.PushBack(1), d.PushBack(2), d.PushBack(3)
+
+	if v := two.Remove(); v != 2 {
+		t.Errorf("Remove() = %d, want 2", v)
+	}
+	checkDeque(t, d, 1, 3)
+	if v := two.Remove(); v != 2 {
+		t.Errorf("second Remove() = %d, want 2", v)
+	}
+	checkDeque(t, d, 1, 3)
+	if two.Next() != nil || two.Prev() != nil {
+		t.Error("removed element still links into the deque")
+	}
+
+	one.Remove()
+	checkDeque(t, d, 3)
+	three.Remove()
+	checkDeque(t, d)
+	three.Remove()
+	checkDeque(t, d)
+}
+
+func TestDequeMove(t *testing.T) {
+	d := NewDeque[int]()
+	one, two, three := d.PushBack(1), d.PushBack(2), d.PushBack(3)
+
+	three.MoveToFront()
+	checkDeque(t, d, 3, 1, 2)
+	three.MoveToFront()
+	checkDeque(t, d, 3, 1, 2)
+	three.MoveToBack()
+	checkDeque(t, d, 1, 2, 3)
+	three.MoveToBack()
+	checkDeque(t, d, 1, 2, 3)
+	two.MoveToFront()
+	checkDeque(t, d, 2, 1, 3)
+	one.MoveToBack()
+	checkDeque(t, d, 2, 3, 1)
+
+	one.Remove()
+	one.MoveToFront()
+	checkDeque(t, d, 2, 3)
+	one.MoveToBack()
+	checkDeque(t, d, 2, 3)
+
+	other := NewDeque[int]()
+	foreign := other.PushBack(4)
+	other.PushBack(5)
+	foreign.MoveToBack()
+	checkDeque(t, d, 2, 3)
+	checkDeque(t, other, 5, 4)
+	foreign.MoveToFront()
+	checkDeque(t, d, 2, 3)
+	checkDeque(t, other, 4, 5)
+}
+
+func TestDequeZeroValue(t *testing.T) {
+	var d Deque[int]
+	d.PushFront(1).InsertAfter(2)
+	checkDeque(t, &d, 1, 2)
+}
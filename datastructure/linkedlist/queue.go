@@ -5,6 +5,7 @@ Each node has a 'value' that stores the integer, and pointer to its next and pre
  and removes element from the back of the queue with the regular methods
  i.e. Push() and Pop()
  AddToEnd and RemoveFromFront method is also added as a part of doubly linkedlist
+ For values of other types see the generic Deque.
 */
 
 package linkedlist
@@ -34,6 +35,11 @@ func (a *Queue) Push(v int) {
 	a.size++
 }
 
+// Len returns the number of elements in the queue.
+func (a *Queue) Len() int {
+	return a.size
+}
+
 func (a *Queue) isEmpty() bool {
 	return a.size == 0
 }
@@ -0,0 +1,212 @@
+/* This is an implementation of a bounded blocking queue built on the generic linked-list Deque.
+Put blocks while the queue is full and Take blocks while it is empty, both give up when their
+context is cancelled or its deadline passes. TryPut and TryTake never block.
+Waiters are woken through a channel which is closed and replaced on every change of the queue
+while goroutines are waiting, so a waiting goroutine can select on it together with its context.
+Close wakes all waiters: pending and later Puts fail with ErrClosed, while Takes keep returning
+the remaining values and fail with ErrClosed once the queue is empty.
+*/
+
+package queue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dev-crusader/data-structures-and-algorithms/datastructure/linkedlist"
+)
+
+// ErrClosed is returned by the operations of a closed BlockingQueue.
+var ErrClosed = errors.New("queue: closed")
+
+// BlockingQueue is a FIFO queue safe for concurrent use whose Put and Take block until
+// they can proceed. It must be created with NewBlockingQueue.
+type BlockingQueue[T any] struct {
+	mu       sync.Mutex
+	items    linkedlist.Deque[T]
+	capacity int
+	closed   bool
+	changed  chan struct{} // closed and replaced whenever the queue changes while waiters > 0
+	waiters  int           // number of goroutines blocked in wait
+}
+
+// NewBlockingQueue creates a new BlockingQueue holding at most capacity values.
+// A capacity of zero or less makes the queue unbounded.
+func NewBlockingQueue[T any](capacity int) *BlockingQueue[T] {
+	return &BlockingQueue[T]{
+		capacity: capacity,
+		changed:  make(chan struct{}),
+	}
+}
+
+// notify wakes up every waiter. It must be called with the lock held.
+func (q *BlockingQueue[T]) notify() {
+	if q.waiters == 0 {
+		return
+	}
+	close(q.changed)
+	q.changed = make(chan struct{})
+}
+
+func (q *BlockingQueue[T]) full() bool {
+	return q.capacity > 0 && q.items.Len() >= q.capacity
+}
+
+// wait releases the lock until the queue changes or the context is done.
+// It must be called with the lock held and returns with the lock held.
+func (q *BlockingQueue[T]) wait(ctx context.Context) error {
+	changed := q.changed
+	q.waiters++
+	q.mu.Unlock()
+	defer func() {
+		q.mu.Lock()
+		q.waiters--
+	}()
+
+	select {
+	case <-changed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Put adds v to the back of the queue, blocking while the queue is full.
+// It returns the context error if ctx is done first, or ErrClosed if the queue is closed.
+func (q *BlockingQueue[T]) Put(ctx context.Context, v T) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for {
+		if q.closed {
+			return ErrClosed
+		}
+		if !q.full() {
+			q.items.PushBack(v)
+			q.notify()
+			return nil
+		}
+		if err := q.wait(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+// Take removes and returns the value at the front of the queue, blocking while it is empty.
+// It returns the context error if ctx is done first, or ErrClosed if the queue is closed and empty.
+func (q *BlockingQueue[T]) Take(ctx context.Context) (T, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for {
+		if v, ok := q.items.PopFront(); ok {
+			q.notify()
+			return v, nil
+		}
+		if q.closed {
+			var zeroValue T
+			return zeroValue, ErrClosed
+		}
+		if err := q.wait(ctx); err != nil {
+			var zeroValue T
+			return zeroValue, err
+		}
+	}
+}
+
+// TryPut adds v to the back of the queue if there is room and reports whether it was added.
+func (q *BlockingQueue[T]) TryPut(v T) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed || q.full() {
+		return false
+	}
+	q.items.PushBack(v)
+	q.notify()
+	return true
+}
+
+// TryTake removes and returns the value at the front of the queue if there is one.
+func (q *BlockingQueue[T]) TryTake() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	v, ok := q.items.PopFront()
+	if ok {
+		q.notify()
+	}
+	return v, ok
+}
+
+// Drain removes and returns all values of the queue in FIFO order without blocking.
+func (q *BlockingQueue[T]) Drain() []T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	values := q.items.GetAll()
+	if len(values) > 0 {
+		q.items = linkedlist.Deque[T]{}
+		q.notify()
+	}
+	return values
+}
+
+// Close closes the queue and wakes all blocked goroutines. Closing twice has no effect.
+func (q *BlockingQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if !q.closed {
+		q.closed = true
+		q.notify()
+	}
+}
+
+// Len returns the number of values in the queue.
+func (q *BlockingQueue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.items.Len()
+}
+
+// Cap returns the capacity of the queue, zero if it is unbounded.
+func (q *BlockingQueue[T]) Cap() int {
+	return max(q.capacity, 0)
+}
+
+func RunBlockingQueue() {
+	q := NewBlockingQueue[int](2)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			v, err := q.Take(ctx)
+			if err != nil {
+				fmt.Println("Consumer stopped:", err)
+				return
+			}
+			fmt.Println("Took:", v)
+			time.Sleep(10 * time.Millisecond) // Simulate a slow consumer
+		}
+	}()
+
+	// Put blocks whenever the two slots are taken until the consumer catches up.
+	for i := 1; i <= 5; i++ {
+		if err := q.Put(ctx, i); err != nil {
+			fmt.Println("Put failed:", err)
+		}
+	}
+	q.Close()
+	wg.Wait()
+	fmt.Println("Put after close:", q.Put(ctx, 6))
+
+	// Without a consumer a full queue makes Put wait until its deadline.
+	bounded := NewBlockingQueue[string](1)
+	fmt.Println("TryPut:", bounded.TryPut("first"))
+	fmt.Println("TryPut:", bounded.TryPut("second"))
+	timeout, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	fmt.Println("Put with deadline:", bounded.Put(timeout, "third"))
+	fmt.Println("Drain:", bounded.Drain())
+}
@@ -0,0 +1,304 @@
+package queue
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// blockedFor is how long an operation must stay pending to count as blocked.
+const blockedFor = 20 * time.Millisecond
+
+// async runs f in a goroutine and returns a channel receiving its error.
+func async(f func() error) <-chan error {
+	done := make(chan error, 1)
+	go func() { done <- f() }()
+	return done
+}
+
+func assertBlocked(t *testing.T, done <-chan error) {
+	t.Helper()
+	select {
+	case err := <-done:
+		t.Fatalf("operation returned %v, want it blocked", err)
+	case <-time.After(blockedFor):
+	}
+}
+
+func assertDone(t *testing.T, done <-chan error, want error) {
+	t.Helper()
+	select {
+	case err := <-done:
+		if !errors.Is(err, want) {
+			t.Fatalf("operation returned %v, want %v", err, want)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("operation still blocked")
+	}
+}
+
+// waitForWaiters waits until n goroutines are blocked in the queue.
+func waitForWaiters[T any](t *testing.T, q *BlockingQueue[T], n int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for {
+		q.mu.Lock()
+		waiters := q.waiters
+		q.mu.Unlock()
+		if waiters == n {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%d goroutines waiting, want %d", waiters, n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestBlockingQueuePutBlocksWhileFull(t *testing.T) {
+	q := NewBlockingQueue[int](2)
+	ctx := context.Background()
+	q.Put(ctx, 1)
+	q.Put(ctx, 2)
+
+	done := async(func() error { return q.Put(ctx, 3) })
+	assertBlocked(t, done)
+	if v, err := q.Take(ctx); err != nil || v != 1 {
+		t.Fatalf("Take() = %d, %v; want 1, nil", v, err)
+	}
+	assertDone(t, done, nil)
+	if got := q.Drain(); !slices.Equal(got, []int{2, 3}) {
+		t.Errorf("Drain() = %v, want [2 3]", got)
+	}
+}
+
+func TestBlockingQueueTakeBlocksWhileEmpty(t *testing.T) {
+	q := NewBlockingQueue[int](2)
+	ctx := context.Background()
+
+	var got int
+	done := async(func() error {
+		var err error
+		got, err = q.Take(ctx)
+		return err
+	})
+	assertBlocked(t, done)
+	q.Put(ctx, 1)
+	assertDone(t, done, nil)
+	if got != 1 {
+		t.Errorf("Take() = %d, want 1", got)
+	}
+}
+
+func TestBlockingQueueContext(t *testing.T) {
+	full := NewBlockingQueue[int](1)
+	full.Put(context.Background(), 1)
+	empty := NewBlockingQueue[int](1)
+	take := func(ctx context.Context) error {
+		_, err := empty.Take(ctx)
+		return err
+	}
+	put := func(ctx context.Context) error {
+		return full.Put(ctx, 2)
+	}
+
+	for name, op := range map[string]func(context.Context) error{"Put": put, "Take": take} {
+		t.Run(name+"Cancel", func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			done := async(func() error { return op(ctx) })
+			assertBlocked(t, done)
+			cancel()
+			assertDone(t, done, context.Canceled)
+		})
+		t.Run(name+"Deadline", func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), blockedFor)
+			defer cancel()
+			assertDone(t, async(func() error { return op(ctx) }), context.DeadlineExceeded)
+		})
+	}
+	if full.Len() != 1 || empty.Len() != 0 {
+		t.Errorf("Len() = %d and %d after giving up, want 1 and 0", full.Len(), empty.Len())
+	}
+	if full.waiters != 0 || empty.waiters != 0 {
+		t.Errorf("%d and %d waiters left after giving up, want none", full.waiters, empty.waiters)
+	}
+}
+
+func TestBlockingQueueClose(t *testing.T) {
+	ctx := context.Background()
+
+	empty := NewBlockingQueue[int](1)
+	full := NewBlockingQueue[int](1)
+	full.Put(ctx, 1)
+	var waiters []<-chan error
+	for range 3 {
+		waiters = append(waiters, async(func() error {
+			_, err := empty.Take(ctx)
+			return err
+		}))
+	}
+	for i := range 3 {
+		waiters = append(waiters, async(func() error { return full.Put(ctx, i) }))
+	}
+	waitForWaiters(t, empty, 3)
+	waitForWaiters(t, full, 3)
+
+	empty.Close()
+	full.Close()
+	full.Close()
+	for _, done := range waiters {
+		assertDone(t, done, ErrClosed)
+	}
+
+	if err := full.Put(ctx, 2); !errors.Is(err, ErrClosed) {
+		t.Errorf("Put() after Close = %v, want ErrClosed", err)
+	}
+	if full.TryPut(2) {
+		t.Error("TryPut() after Close = true")
+	}
+	if v, err := full.Take(ctx); err != nil || v != 1 {
+		t.Errorf("Take() after Close = %d, %v; want the buffered 1, nil", v, err)
+	}
+	if _, err := full.Take(ctx); !errors.Is(err, ErrClosed) {
+		t.Errorf("Take() on a closed and drained queue = %v, want ErrClosed", err)
+	}
+}
+
+func TestBlockingQueueTry(t *testing.T) {
+	q := NewBlockingQueue[int](2)
+	if _, ok := q.TryTake(); ok {
+		t.Error("TryTake() on an empty queue = true")
+	}
+	if !q.TryPut(1) || !q.TryPut(2) {
+		t.Fatal("TryPut() with room left = false")
+	}
+	if q.TryPut(3) {
+		t.Error("TryPut() on a full queue = true")
+	}
+	if v, ok := q.TryTake(); !ok || v != 1 {
+		t.Errorf("TryTake() = %d, %t; want 1, true", v, ok)
+	}
+	if q.Len() != 1 || q.Cap() != 2 {
+		t.Errorf("Len(), Cap() = %d, %d; want 1, 2", q.Len(), q.Cap())
+	}
+
+	unbounded := NewBlockingQueue[int](0)
+	for i := range 100 {
+		if !unbounded.TryPut(i) {
+			t.Fatalf("TryPut(%d) on an unbounded queue = false", i)
+		}
+	}
+	if unbounded.Cap() != 0 {
+		t.Errorf("Cap() of an unbounded queue = %d, want 0", unbounded.Cap())
+	}
+}
+
+func TestBlockingQueueTryWakesWaiters(t *testing.T) {
+	q := NewBlockingQueue[int](1)
+	ctx := context.Background()
+
+	var got int
+	take := async(func() error {
+		var err error
+		got, err = q.Take(ctx)
+		return err
+	})
+	waitForWaiters(t, q, 1)
+	q.TryPut(1)
+	assertDone(t, take, nil)
+	if got != 1 {
+		t.Errorf("Take() = %d, want 1", got)
+	}
+
+	q.TryPut(2)
+	put := async(func() error { return q.Put(ctx, 3) })
+	waitForWaiters(t, q, 1)
+	q.TryTake()
+	assertDone(t, put, nil)
+}
+
+func TestBlockingQueueDrain(t *testing.T) {
+	q := NewBlockingQueue[int](3)
+	ctx := context.Background()
+	if got := q.Drain(); len(got) != 0 {
+		t.Errorf("Drain() on an empty queue = %v", got)
+	}
+	for i := range 3 {
+		q.Put(ctx, i)
+	}
+	put := async(func() error { return q.Put(ctx, 3) })
+	waitForWaiters(t, q, 1)
+
+	if got := q.Drain(); !slices.Equal(got, []int{0, 1, 2}) {
+		t.Errorf("Drain() = %v, want [0 1 2]", got)
+	}
+	assertDone(t, put, nil)
+	if got := q.Drain(); !slices.Equal(got, []int{3}) {
+		t.Errorf("Drain() = %v, want [3]", got)
+	}
+}
+
+func TestBlockingQueueNotifyWithoutWaiters(t *testing.T) {
+	q := NewBlockingQueue[int](1)
+	changed := q.changed
+	q.TryPut(1)
+	q.TryTake()
+	q.Put(context.Background(), 1)
+	q.Take(context.Background())
+	if q.changed != changed {
+		t.Error("the wake-up channel was replaced without any waiter")
+	}
+}
+
+func TestBlockingQueueConcurrent(t *testing.T) {
+	const workers, perWorker = 4, 500
+	q := NewBlockingQueue[int](8)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for w := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range perWorker {
+				if err := q.Put(ctx, w*perWorker+i); err != nil {
+					t.Errorf("Put() = %v", err)
+					return
+				}
+			}
+		}()
+	}
+
+	seen := make([]bool, workers*perWorker)
+	var consumers sync.WaitGroup
+	var mu sync.Mutex
+	for range workers {
+		consumers.Add(1)
+		go func() {
+			defer consumers.Done()
+			for {
+				v, err := q.Take(ctx)
+				if err != nil {
+					return
+				}
+				mu.Lock()
+				if seen[v] {
+					t.Errorf("value %d taken twice", v)
+				}
+				seen[v] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	q.Close()
+	consumers.Wait()
+	for v, ok := range seen {
+		if !ok {
+			t.Errorf("value %d never taken", v)
+		}
+	}
+}
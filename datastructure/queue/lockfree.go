@@ -0,0 +1,252 @@
+/* This file holds two lock-free multi-producer/multi-consumer queues built on sync/atomic.
+
+LockFreeQueue is the unbounded Michael-Scott queue: a singly linked list with a dummy head node
+where producers link new nodes at the tail and consumers advance the head, both with compare-and-swap.
+A goroutine which finds the tail lagging behind helps to swing it forward, so no operation ever
+waits for another one to finish. Go's garbage collector rules out the ABA problem of the original
+algorithm, as a node cannot be reused while any goroutine still holds a pointer to it.
+
+LockFreeBoundedQueue is Dmitry Vyukov's bounded array queue: every cell of a power-of-two ring
+carries a sequence number telling producers and consumers whether the cell is free for the current
+lap, so a single compare-and-swap on the enqueue or dequeue position claims a cell.
+*/
+
+package queue
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type lockFreeNode[T any] struct {
+	value T
+	next  atomic.Pointer[lockFreeNode[T]]
+}
+
+// LockFreeQueue is an unbounded lock-free FIFO queue safe for concurrent use.
+// It must be created with NewLockFreeQueue.
+type LockFreeQueue[T any] struct {
+	head atomic.Pointer[lockFreeNode[T]] // dummy node, the front value is in head.next
+	_    [64]byte                        // keep head and tail on separate cache lines
+	tail atomic.Pointer[lockFreeNode[T]]
+}
+
+// NewLockFreeQueue creates a new empty LockFreeQueue.
+func NewLockFreeQueue[T any]() *LockFreeQueue[T] {
+	q := &LockFreeQueue[T]{}
+	dummy := &lockFreeNode[T]{}
+	q.head.Store(dummy)
+	q.tail.Store(dummy)
+	return q
+}
+
+// Enqueue adds v to the back of the queue.
+func (q *LockFreeQueue[T]) Enqueue(v T) {
+	node := &lockFreeNode[T]{value: v}
+	for {
+		tail := q.tail.Load()
+		next := tail.next.Load()
+		if tail != q.tail.Load() {
+			continue
+		}
+		if next != nil {
+			// the tail is lagging behind, help to move it forward
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if tail.next.CompareAndSwap(nil, node) {
+			q.tail.CompareAndSwap(tail, node)
+			return
+		}
+	}
+}
+
+// Dequeue removes and returns the value at the front of the queue, if there is one.
+func (q *LockFreeQueue[T]) Dequeue() (T, bool) {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		next := head.next.Load()
+		if head != q.head.Load() {
+			continue
+		}
+		if next == nil {
+			var zeroValue T
+			return zeroValue, false
+		}
+		if head == tail {
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		// next becomes the new dummy node and keeps its value until the following Dequeue
+		v := next.value
+		if q.head.CompareAndSwap(head, next) {
+			return v, true
+		}
+	}
+}
+
+// IsEmpty reports whether the queue held no value at the time of the call.
+func (q *LockFreeQueue[T]) IsEmpty() bool {
+	return q.head.Load().next.Load() == nil
+}
+
+type lockFreeCell[T any] struct {
+	sequence atomic.Uint64
+	value    T
+}
+
+// LockFreeBoundedQueue is a fixed-capacity lock-free FIFO queue safe for concurrent use.
+// It must be created with NewLockFreeBoundedQueue.
+type LockFreeBoundedQueue[T any] struct {
+	cells      []lockFreeCell[T]
+	mask       uint64
+	_          [64]byte // keep the positions on separate cache lines
+	enqueuePos atomic.Uint64
+	_          [64]byte
+	dequeuePos atomic.Uint64
+}
+
+// NewLockFreeBoundedQueue creates a new LockFreeBoundedQueue holding at least capacity values.
+// The capacity is rounded up to a power of two.
+func NewLockFreeBoundedQueue[T any](capacity int) *LockFreeBoundedQueue[T] {
+	size := 2
+	for size < capacity {
+		size <<= 1
+	}
+	q := &LockFreeBoundedQueue[T]{
+		cells: make([]lockFreeCell[T], size),
+		mask:  uint64(size - 1),
+	}
+	for i := range q.cells {
+		q.cells[i].sequence.Store(uint64(i))
+	}
+	return q
+}
+
+// TryEnqueue adds v to the back of the queue and reports whether there was room for it.
+func (q *LockFreeBoundedQueue[T]) TryEnqueue(v T) bool {
+	pos := q.enqueuePos.Load()
+	for {
+		cell := &q.cells[pos&q.mask]
+		diff := int64(cell.sequence.Load()) - int64(pos)
+		switch {
+		case diff == 0:
+			// the cell is free for this lap, try to claim it
+			if q.enqueuePos.CompareAndSwap(pos, pos+1) {
+				cell.value = v
+				cell.sequence.Store(pos + 1)
+				return true
+			}
+			pos = q.enqueuePos.Load()
+		case diff < 0:
+			// the cell still holds a value of the previous lap, the queue is full
+			return false
+		default:
+			pos = q.enqueuePos.Load()
+		}
+	}
+}
+
+// TryDequeue removes and returns the value at the front of the queue, if there is one.
+func (q *LockFreeBoundedQueue[T]) TryDequeue() (T, bool) {
+	var zeroValue T
+	pos := q.dequeuePos.Load()
+	for {
+		cell := &q.cells[pos&q.mask]
+		diff := int64(cell.sequence.Load()) - int64(pos+1)
+		switch {
+		case diff == 0:
+			// the cell holds a value for this lap, try to claim it
+			if q.dequeuePos.CompareAndSwap(pos, pos+1) {
+				v := cell.value
+				cell.value = zeroValue
+				cell.sequence.Store(pos + q.mask + 1)
+				return v, true
+			}
+			pos = q.dequeuePos.Load()
+		case diff < 0:
+			// the cell has not been filled yet, the queue is empty
+			return zeroValue, false
+		default:
+			pos = q.dequeuePos.Load()
+		}
+	}
+}
+
+// Cap returns the capacity of the queue.
+func (q *LockFreeBoundedQueue[T]) Cap() int {
+	return len(q.cells)
+}
+
+// measure runs producers, each putting items values into a queue, and consumers taking them
+// back out, and returns the elapsed time.
+// put and take must not block forever, take reports false when no value was available.
+func measure(producers, consumers, items int, put func(int), take func() (int, bool)) time.Duration {
+	start := time.Now()
+	var consumed atomic.Int64
+	total := int64(producers * items)
+
+	var wg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < items; i++ {
+				put(i)
+			}
+		}()
+	}
+	for c := 0; c < consumers; c++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for consumed.Load() < total {
+				if _, ok := take(); ok {
+					consumed.Add(1)
+				} else {
+					runtime.Gosched()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return time.Since(start)
+}
+
+// RunLockFreeQueue compares the lock-free queues with a buffered channel and the mutex based
+// BlockingQueue, the task queue options of the worker pools.
+func RunLockFreeQueue() {
+	const producers, consumers, items, capacity = 4, 4, 100000, 1024
+
+	ch := make(chan int, capacity)
+	took := measure(producers, consumers, items, func(v int) { ch <- v }, func() (int, bool) {
+		select {
+		case v := <-ch:
+			return v, true
+		default:
+			return 0, false
+		}
+	})
+	fmt.Printf("Channel:              took %d nanoseconds\n", took.Nanoseconds())
+
+	blocking := NewBlockingQueue[int](capacity)
+	took = measure(producers, consumers, items, func(v int) { blocking.Put(context.Background(), v) }, blocking.TryTake)
+	fmt.Printf("BlockingQueue:        took %d nanoseconds\n", took.Nanoseconds())
+
+	lockFree := NewLockFreeQueue[int]()
+	took = measure(producers, consumers, items, lockFree.Enqueue, lockFree.Dequeue)
+	fmt.Printf("LockFreeQueue:        took %d nanoseconds\n", took.Nanoseconds())
+
+	bounded := NewLockFreeBoundedQueue[int](capacity)
+	took = measure(producers, consumers, items, func(v int) {
+		for !bounded.TryEnqueue(v) {
+			runtime.Gosched()
+		}
+	}, bounded.TryDequeue)
+	fmt.Printf("LockFreeBoundedQueue: took %d nanoseconds\n", took.Nanoseconds())
+}
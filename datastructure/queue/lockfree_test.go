@@ -0,0 +1,181 @@
+package queue
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+const (
+	producers = 4
+	consumers = 4
+	items     = 10000
+)
+
+// testExactlyOnce runs producers putting items distinct values each and consumers taking
+// them, then checks that every value was taken exactly once and that each consumer saw the
+// values of every producer in the order they were put.
+func testExactlyOnce(t *testing.T, put func(int), take func() (int, bool)) {
+	t.Helper()
+	taken := make([][]int, consumers)
+	var remaining sync.WaitGroup
+	remaining.Add(producers * items)
+
+	var wg sync.WaitGroup
+	for p := range producers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range items {
+				put(p*items + i)
+			}
+		}()
+	}
+	done := make(chan struct{})
+	for c := range consumers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				if v, ok := take(); ok {
+					taken[c] = append(taken[c], v)
+					remaining.Done()
+					continue
+				}
+				select {
+				case <-done:
+					return
+				default:
+					runtime.Gosched()
+				}
+			}
+		}()
+	}
+	remaining.Wait()
+	close(done)
+	wg.Wait()
+
+	seen := make([]int, producers*items)
+	for c, values := range taken {
+		last := make([]int, producers)
+		for p := range last {
+			last[p] = -1
+		}
+		for _, v := range values {
+			seen[v]++
+			p, i := v/items, v%items
+			if i <= last[p] {
+				t.Errorf("consumer %d took %d after %d from producer %d", c, i, last[p], p)
+			}
+			last[p] = i
+		}
+	}
+	for v, n := range seen {
+		if n != 1 {
+			t.Errorf("value %d taken %d times, want once", v, n)
+		}
+	}
+	if v, ok := take(); ok {
+		t.Errorf("take() = %d after all values were taken, want empty", v)
+	}
+}
+
+func TestLockFreeQueue(t *testing.T) {
+	q := NewLockFreeQueue[int]()
+	if !q.IsEmpty() {
+		t.Fatal("new queue is not empty")
+	}
+	for i := range 3 {
+		q.Enqueue(i)
+	}
+	for i := range 3 {
+		if v, ok := q.Dequeue(); !ok || v != i {
+			t.Fatalf("Dequeue() = %d, %t; want %d, true", v, ok, i)
+		}
+	}
+	if _, ok := q.Dequeue(); ok || !q.IsEmpty() {
+		t.Fatal("queue not empty after dequeuing every value")
+	}
+
+	testExactlyOnce(t, q.Enqueue, q.Dequeue)
+}
+
+func TestLockFreeBoundedQueue(t *testing.T) {
+	q := NewLockFreeBoundedQueue[int](5)
+	if q.Cap() != 8 {
+		t.Errorf("Cap() = %d, want 5 rounded up to 8", q.Cap())
+	}
+	for i := range q.Cap() {
+		if !q.TryEnqueue(i) {
+			t.Fatalf("TryEnqueue(%d) failed below capacity", i)
+		}
+	}
+	if q.TryEnqueue(-1) {
+		t.Fatal("TryEnqueue succeeded on a full queue")
+	}
+	for i := range q.Cap() {
+		if v, ok := q.TryDequeue(); !ok || v != i {
+			t.Fatalf("TryDequeue() = %d, %t; want %d, true", v, ok, i)
+		}
+	}
+	if _, ok := q.TryDequeue(); ok {
+		t.Fatal("TryDequeue succeeded on an empty queue")
+	}
+
+	// a small capacity makes the producers wrap around the ring many times
+	q = NewLockFreeBoundedQueue[int](16)
+	testExactlyOnce(t, func(v int) {
+		for !q.TryEnqueue(v) {
+			runtime.Gosched()
+		}
+	}, q.TryDequeue)
+}
+
+const benchmarkCapacity = 1024
+
+// benchmarkQueue measures a put followed by a take on every goroutine of b.RunParallel.
+func benchmarkQueue(b *testing.B, put func(int), take func() (int, bool)) {
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			put(i)
+			for {
+				if _, ok := take(); ok {
+					break
+				}
+				runtime.Gosched()
+			}
+		}
+	})
+}
+
+func BenchmarkChannel(b *testing.B) {
+	ch := make(chan int, benchmarkCapacity)
+	benchmarkQueue(b, func(v int) { ch <- v }, func() (int, bool) {
+		select {
+		case v := <-ch:
+			return v, true
+		default:
+			return 0, false
+		}
+	})
+}
+
+func BenchmarkBlockingQueue(b *testing.B) {
+	q := NewBlockingQueue[int](benchmarkCapacity)
+	benchmarkQueue(b, func(v int) { q.Put(context.Background(), v) }, q.TryTake)
+}
+
+func BenchmarkLockFreeQueue(b *testing.B) {
+	q := NewLockFreeQueue[int]()
+	benchmarkQueue(b, q.Enqueue, q.Dequeue)
+}
+
+func BenchmarkLockFreeBoundedQueue(b *testing.B) {
+	q := NewLockFreeBoundedQueue[int](benchmarkCapacity)
+	benchmarkQueue(b, func(v int) {
+		for !q.TryEnqueue(v) {
+			runtime.Gosched()
+		}
+	}, q.TryDequeue)
+}
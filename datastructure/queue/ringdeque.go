@@ -0,0 +1,274 @@
+/* This is an implementation of a double-ended queue backed by a circular buffer.
+The buffer length is always a power of two so positions wrap around with a bit mask.
+It doubles when full and halves once it is a quarter full, which keeps pushes and pops
+amortized O(1) without allocating a node per element as linkedlist.Deque does.
+RingDeque exposes the same method names as linkedlist.Deque, and those of linkedlist.Queue
+as aliases, so it can replace either of them.
+*/
+
+package queue
+
+import (
+	"fmt"
+	"iter"
+)
+
+const minRingCapacity = 8
+
+// RingDeque is a generic double-ended queue backed by a growable ring buffer.
+// The zero value is an empty deque ready to use.
+type RingDeque[T any] struct {
+	buf  []T
+	head int // index of the front element
+	size int
+}
+
+// NewRingDeque creates a new RingDeque able to hold capacity elements before growing.
+func NewRingDeque[T any](capacity int) *RingDeque[T] {
+	return &RingDeque[T]{buf: make([]T, ringCapacity(capacity))}
+}
+
+// ringCapacity rounds n up to a power of two of at least minRingCapacity.
+func ringCapacity(n int) int {
+	capacity := minRingCapacity
+	for capacity < n {
+		capacity <<= 1
+	}
+	return capacity
+}
+
+// index maps a logical position to a buffer index.
+func (r *RingDeque[T]) index(i int) int {
+	return (r.head + i) & (len(r.buf) - 1)
+}
+
+// resize copies the elements into a new buffer of the given capacity, starting at index 0.
+func (r *RingDeque[T]) resize(capacity int) {
+	buf := make([]T, capacity)
+	if r.size > 0 {
+		if end := r.head + r.size; end <= len(r.buf) {
+			copy(buf, r.buf[r.head:end])
+		} else {
+			n := copy(buf, r.buf[r.head:])
+			copy(buf[n:], r.buf[:end-len(r.buf)])
+		}
+	}
+	r.buf = buf
+	r.head = 0
+}
+
+func (r *RingDeque[T]) grow() {
+	if len(r.buf) == 0 {
+		r.buf = make([]T, minRingCapacity)
+	} else if r.size == len(r.buf) {
+		r.resize(len(r.buf) * 2)
+	}
+}
+
+func (r *RingDeque[T]) shrink() {
+	if len(r.buf) > minRingCapacity && r.size <= len(r.buf)/4 {
+		r.resize(len(r.buf) / 2)
+	}
+}
+
+// PushFront adds v to the front of the deque.
+func (r *RingDeque[T]) PushFront(v T) {
+	r.grow()
+	r.head = (r.head - 1) & (len(r.buf) - 1)
+	r.buf[r.head] = v
+	r.size++
+}
+
+// PushBack adds v to the back of the deque.
+func (r *RingDeque[T]) PushBack(v T) {
+	r.grow()
+	r.buf[r.index(r.size)] = v
+	r.size++
+}
+
+// PushBackSlice adds all values to the back of the deque, growing the buffer at most once.
+func (r *RingDeque[T]) PushBackSlice(values ...T) {
+	if needed := r.size + len(values); needed > len(r.buf) {
+		r.resize(ringCapacity(needed))
+	}
+	for _, v := range values {
+		r.buf[r.index(r.size)] = v
+		r.size++
+	}
+}
+
+// PopFront removes and returns the value at the front of the deque.
+func (r *RingDeque[T]) PopFront() (T, bool) {
+	var zeroValue T
+	if r.size == 0 {
+		return zeroValue, false
+	}
+	v := r.buf[r.head]
+	r.buf[r.head] = zeroValue // release the reference for the garbage collector
+	r.head = r.index(1)
+	r.size--
+	r.shrink()
+	return v, true
+}
+
+// PopBack removes and returns the value at the back of the deque.
+func (r *RingDeque[T]) PopBack() (T, bool) {
+	var zeroValue T
+	if r.size == 0 {
+		return zeroValue, false
+	}
+	i := r.index(r.size - 1)
+	v := r.buf[i]
+	r.buf[i] = zeroValue
+	r.size--
+	r.shrink()
+	return v, true
+}
+
+// Push adds v to the front of the deque, like linkedlist.Queue.Push. It is an alias of PushFront.
+func (r *RingDeque[T]) Push(v T) {
+	r.PushFront(v)
+}
+
+// Pop removes and returns the value at the back of the deque, like linkedlist.Queue.Pop.
+// It is an alias of PopBack.
+func (r *RingDeque[T]) Pop() (T, bool) {
+	return r.PopBack()
+}
+
+// AddToEnd adds v to the back of the deque, like linkedlist.Queue.AddToEnd. It is an alias
+// of PushBack.
+func (r *RingDeque[T]) AddToEnd(v T) {
+	r.PushBack(v)
+}
+
+// RemoveFromFront removes and returns the value at the front of the deque, like
+// linkedlist.Queue.RemoveFromFront. It is an alias of PopFront.
+func (r *RingDeque[T]) RemoveFromFront() (T, bool) {
+	return r.PopFront()
+}
+
+// PeekFront returns the value at the front of the deque without removing it.
+func (r *RingDeque[T]) PeekFront() (T, bool) {
+	if r.size == 0 {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return r.buf[r.head], true
+}
+
+// PeekBack returns the value at the back of the deque without removing it.
+func (r *RingDeque[T]) PeekBack() (T, bool) {
+	if r.size == 0 {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return r.buf[r.index(r.size-1)], true
+}
+
+// At returns the value at position i counted from the front. It panics if i is out of range.
+func (r *RingDeque[T]) At(i int) T {
+	if i < 0 || i >= r.size {
+		panic(fmt.Sprintf("queue: index %d out of range [0, %d)", i, r.size))
+	}
+	return r.buf[r.index(i)]
+}
+
+// Rotate rotates the deque n steps to the back, i.e. the last n values move to the front.
+// A negative n rotates to the front.
+func (r *RingDeque[T]) Rotate(n int) {
+	if r.size <= 1 {
+		return
+	}
+	n %= r.size
+	if n < 0 {
+		n += r.size
+	}
+	if n == 0 {
+		return
+	}
+	if r.size == len(r.buf) {
+		// a full buffer only needs its head moved
+		r.head = r.index(r.size - n)
+		return
+	}
+	// move the fewest elements across the ends
+	if n <= r.size/2 {
+		for ; n > 0; n-- {
+			v, _ := r.PopBack()
+			r.PushFront(v)
+		}
+	} else {
+		for n = r.size - n; n > 0; n-- {
+			v, _ := r.PopFront()
+			r.PushBack(v)
+		}
+	}
+}
+
+// Len returns the number of elements in the deque.
+func (r *RingDeque[T]) Len() int {
+	return r.size
+}
+
+// Cap returns the length of the underlying buffer.
+func (r *RingDeque[T]) Cap() int {
+	return len(r.buf)
+}
+
+// GetAll returns the values of the deque from front to back.
+func (r *RingDeque[T]) GetAll() []T {
+	result := make([]T, r.size)
+	for i := range result {
+		result[i] = r.buf[r.index(i)]
+	}
+	return result
+}
+
+// All returns an iterator over the values of the deque from front to back.
+func (r *RingDeque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < r.size; i++ {
+			if !yield(r.buf[r.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the values of the deque from back to front.
+func (r *RingDeque[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := r.size - 1; i >= 0; i-- {
+			if !yield(r.buf[r.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+func RunRingDeque() {
+	r := NewRingDeque[int](4)
+	r.PushBackSlice(1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	r.PushFront(0)
+	fmt.Println(r.GetAll(), "Len:", r.Len(), "Cap:", r.Cap())
+	fmt.Println("At(3):", r.At(3))
+
+	r.Rotate(3)
+	fmt.Println(r.GetAll())
+	r.Rotate(-3)
+	fmt.Println(r.GetAll())
+
+	for r.Len() > 2 {
+		r.PopFront()
+	}
+	fmt.Println(r.GetAll(), "Len:", r.Len(), "Cap:", r.Cap())
+	fmt.Println(r.PeekFront())
+	fmt.Println(r.PeekBack())
+
+	// the linkedlist.Queue names: Push at the front, Pop from the back
+	r.Push(-1)
+	r.AddToEnd(11)
+	fmt.Println(r.Pop())
+	fmt.Println(r.RemoveFromFront())
+}
@@ -0,0 +1,73 @@
+package queue
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/dev-crusader/data-structures-and-algorithms/datastructure/linkedlist"
+)
+
+// intQueue is the method set of linkedlist.Queue, which RingDeque[int] provides as well.
+type intQueue interface {
+	Push(v int)
+	Pop() (int, bool)
+	AddToEnd(v int)
+	RemoveFromFront() (int, bool)
+	Len() int
+	GetAll() []int
+}
+
+var (
+	_ intQueue = (*linkedlist.Queue)(nil)
+	_ intQueue = (*RingDeque[int])(nil)
+)
+
+func TestRingDequeReplacesQueue(t *testing.T) {
+	r := rand.New(rand.NewPCG(5, 5))
+	queues := []intQueue{&linkedlist.Queue{}, &RingDeque[int]{}}
+	for step := range 5000 {
+		op, v := r.IntN(4), r.IntN(1000)
+		var values [2]int
+		var oks [2]bool
+		for i, q := range queues {
+			switch op {
+			case 0:
+				q.Push(v)
+			case 1:
+				q.AddToEnd(v)
+			case 2:
+				values[i], oks[i] = q.Pop()
+			case 3:
+				values[i], oks[i] = q.RemoveFromFront()
+			}
+		}
+		// only the value returned from an empty queue differs, -1 against the zero value
+		if oks[0] != oks[1] || oks[0] && values[0] != values[1] {
+			t.Fatalf("step %d: operation %d returned %d, %t from Queue and %d, %t from RingDeque",
+				step, op, values[0], oks[0], values[1], oks[1])
+		}
+		if a, b := queues[0].GetAll(), queues[1].GetAll(); !slices.Equal(a, b) {
+			t.Fatalf("step %d: Queue holds %v, RingDeque %v", step, a, b)
+		}
+	}
+}
+
+func TestRingDequeGrowAndShrink(t *testing.T) {
+	d := NewRingDeque[int](0)
+	for i := range 100 {
+		d.PushBack(i)
+	}
+	if d.Cap() != 128 {
+		t.Errorf("Cap() = %d after 100 values, want 128", d.Cap())
+	}
+	for d.Len() > 3 {
+		d.PopFront()
+	}
+	if d.Cap() > 16 {
+		t.Errorf("Cap() = %d holding 3 values, want it shrunk", d.Cap())
+	}
+	if got := d.GetAll(); !slices.Equal(got, []int{97, 98, 99}) {
+		t.Errorf("GetAll() = %v, want [97 98 99]", got)
+	}
+}
@@ -0,0 +1,104 @@
+package skiplist
+
+import (
+	"cmp"
+	"iter"
+	"sync"
+)
+
+// ConcurrentSkipList is a SkipList safe for concurrent use. Readers share a read lock
+// while writers take the lock exclusively.
+type ConcurrentSkipList[K cmp.Ordered, V any] struct {
+	mu   sync.RWMutex
+	list *SkipList[K, V]
+}
+
+// NewConcurrentSkipList creates a new, empty ConcurrentSkipList with a randomly seeded level generator.
+func NewConcurrentSkipList[K cmp.Ordered, V any]() *ConcurrentSkipList[K, V] {
+	return &ConcurrentSkipList[K, V]{list: NewSkipList[K, V]()}
+}
+
+// NewConcurrentSkipListWithSeed creates a new, empty ConcurrentSkipList whose level generator
+// is seeded with seed.
+func NewConcurrentSkipListWithSeed[K cmp.Ordered, V any](seed uint64) *ConcurrentSkipList[K, V] {
+	return &ConcurrentSkipList[K, V]{list: NewSkipListWithSeed[K, V](seed)}
+}
+
+// Put sets the value for the key. It returns the previous value and whether the key was present.
+func (c *ConcurrentSkipList[K, V]) Put(key K, value V) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.list.Put(key, value)
+}
+
+// Get returns the value stored for the key and whether it was found.
+func (c *ConcurrentSkipList[K, V]) Get(key K) (V, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.Get(key)
+}
+
+// Delete deletes the key and reports whether it was present.
+func (c *ConcurrentSkipList[K, V]) Delete(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.list.Delete(key)
+}
+
+// Len returns the number of keys in the list.
+func (c *ConcurrentSkipList[K, V]) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.Len()
+}
+
+// Floor returns the greatest key less than or equal to key, with its value.
+func (c *ConcurrentSkipList[K, V]) Floor(key K) (K, V, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.Floor(key)
+}
+
+// Ceiling returns the smallest key greater than or equal to key, with its value.
+func (c *ConcurrentSkipList[K, V]) Ceiling(key K) (K, V, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.Ceiling(key)
+}
+
+// Rank returns the number of keys strictly less than key.
+func (c *ConcurrentSkipList[K, V]) Rank(key K) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.Rank(key)
+}
+
+// At returns the key at the given index in sorted order, with its value.
+func (c *ConcurrentSkipList[K, V]) At(index int) (K, V, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list.At(index)
+}
+
+// Range returns an iterator over the keys k with from <= k < to in ascending order.
+// The matching entries are copied under the read lock, so the loop body may modify the list.
+func (c *ConcurrentSkipList[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		type pair struct {
+			key   K
+			value V
+		}
+		c.mu.RLock()
+		var pairs []pair
+		for k, v := range c.list.Range(from, to) {
+			pairs = append(pairs, pair{k, v})
+		}
+		c.mu.RUnlock()
+
+		for _, p := range pairs {
+			if !yield(p.key, p.value) {
+				return
+			}
+		}
+	}
+}
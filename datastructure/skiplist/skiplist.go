@@ -0,0 +1,301 @@
+/*
+	Package skiplist implements a generic sorted map on top of a skip list.
+
+	A skip list is a sorted linked list with extra express lanes: every node is given a random
+	level, and on each level it links to the next node of at least that level. Searches start on
+	the highest lane and drop a level whenever the next key would overshoot, which gives expected
+	O(log n) Put, Get and Delete without any rebalancing.
+
+	Each link also records its span, the number of level 0 nodes it jumps over, so the position
+	(rank) of a key and the key at a given position are found in O(log n) as well.
+
+	The levels are drawn from a random source which can be seeded, so the shape of a list, and
+	therefore any test depending on it, is reproducible.
+*/
+
+package skiplist
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"math/rand/v2"
+)
+
+const (
+	maxLevel = 32
+	// a node reaches the next level with probability 1/4
+	levelProbabilityMask = 3
+)
+
+type node[K cmp.Ordered, V any] struct {
+	key   K
+	value V
+	next  []*node[K, V]
+	span  []int // span[i] is the rank distance to next[i], or to the end of the list if nil
+}
+
+// SkipList is a sorted map from keys to values. It is not safe for concurrent use,
+// see ConcurrentSkipList for that.
+type SkipList[K cmp.Ordered, V any] struct {
+	head   *node[K, V]
+	level  int
+	length int
+	rng    *rand.Rand
+}
+
+// NewSkipList creates a new, empty SkipList with a randomly seeded level generator.
+func NewSkipList[K cmp.Ordered, V any]() *SkipList[K, V] {
+	return NewSkipListWithSeed[K, V](rand.Uint64())
+}
+
+// NewSkipListWithSeed creates a new, empty SkipList whose level generator is seeded with seed.
+// Lists built with the same seed and the same sequence of operations have the same shape.
+func NewSkipListWithSeed[K cmp.Ordered, V any](seed uint64) *SkipList[K, V] {
+	return &SkipList[K, V]{
+		head: &node[K, V]{
+			next: make([]*node[K, V], maxLevel),
+			span: make([]int, maxLevel),
+		},
+		level: 1,
+		rng:   rand.New(rand.NewPCG(seed, seed)),
+	}
+}
+
+func (s *SkipList[K, V]) randomLevel() int {
+	level := 1
+	for level < maxLevel && s.rng.Uint32()&levelProbabilityMask == 0 {
+		level++
+	}
+	return level
+}
+
+// Put sets the value for the key. It returns the previous value and whether the key was present.
+func (s *SkipList[K, V]) Put(key K, value V) (V, bool) {
+	var update [maxLevel]*node[K, V]
+	var rank [maxLevel]int // rank of update[i]
+
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		if i < s.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.next[i] != nil && x.next[i].key < key {
+			rank[i] += x.span[i]
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+	if next := x.next[0]; next != nil && next.key == key {
+		previous := next.value
+		next.value = value
+		return previous, true
+	}
+
+	level := s.randomLevel()
+	if level > s.level {
+		for i := s.level; i < level; i++ {
+			rank[i] = 0
+			update[i] = s.head
+			update[i].span[i] = s.length
+		}
+		s.level = level
+	}
+
+	x = &node[K, V]{
+		key:   key,
+		value: value,
+		next:  make([]*node[K, V], level),
+		span:  make([]int, level),
+	}
+	for i := 0; i < level; i++ {
+		x.next[i] = update[i].next[i]
+		update[i].next[i] = x
+		// rank[0]-rank[i] nodes lie between update[i] and the new node
+		x.span[i] = update[i].span[i] - (rank[0] - rank[i])
+		update[i].span[i] = rank[0] - rank[i] + 1
+	}
+	// links above the new node's level now jump over one more node
+	for i := level; i < s.level; i++ {
+		update[i].span[i]++
+	}
+	s.length++
+
+	var zeroValue V
+	return zeroValue, false
+}
+
+// Get returns the value stored for the key and whether it was found.
+func (s *SkipList[K, V]) Get(key K) (V, bool) {
+	if x := s.ceilingNode(key); x != nil && x.key == key {
+		return x.value, true
+	}
+	var zeroValue V
+	return zeroValue, false
+}
+
+// Delete deletes the key and reports whether it was present.
+func (s *SkipList[K, V]) Delete(key K) bool {
+	var update [maxLevel]*node[K, V]
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && x.next[i].key < key {
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+	x = x.next[0]
+	if x == nil || x.key != key {
+		return false
+	}
+
+	for i := 0; i < s.level; i++ {
+		if update[i].next[i] == x {
+			update[i].span[i] += x.span[i] - 1
+			update[i].next[i] = x.next[i]
+		} else {
+			update[i].span[i]--
+		}
+	}
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+	s.length--
+	return true
+}
+
+// Len returns the number of keys in the list.
+func (s *SkipList[K, V]) Len() int {
+	return s.length
+}
+
+// ceilingNode returns the node with the smallest key greater than or equal to key, or nil.
+func (s *SkipList[K, V]) ceilingNode(key K) *node[K, V] {
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && x.next[i].key < key {
+			x = x.next[i]
+		}
+	}
+	return x.next[0]
+}
+
+// floorNode returns the node with the greatest key less than or equal to key, or nil.
+func (s *SkipList[K, V]) floorNode(key K) *node[K, V] {
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && x.next[i].key <= key {
+			x = x.next[i]
+		}
+	}
+	if x == s.head {
+		return nil
+	}
+	return x
+}
+
+// Floor returns the greatest key less than or equal to key, with its value.
+func (s *SkipList[K, V]) Floor(key K) (K, V, bool) {
+	return entry(s.floorNode(key))
+}
+
+// Ceiling returns the smallest key greater than or equal to key, with its value.
+func (s *SkipList[K, V]) Ceiling(key K) (K, V, bool) {
+	return entry(s.ceilingNode(key))
+}
+
+func entry[K cmp.Ordered, V any](x *node[K, V]) (K, V, bool) {
+	if x == nil {
+		var zeroKey K
+		var zeroValue V
+		return zeroKey, zeroValue, false
+	}
+	return x.key, x.value, true
+}
+
+// Min returns the smallest key of the list, with its value.
+func (s *SkipList[K, V]) Min() (K, V, bool) {
+	return entry(s.head.next[0])
+}
+
+// Max returns the greatest key of the list, with its value.
+func (s *SkipList[K, V]) Max() (K, V, bool) {
+	return s.At(s.length - 1)
+}
+
+// Rank returns the number of keys strictly less than key, i.e. the index key has or would
+// have in sorted order.
+func (s *SkipList[K, V]) Rank(key K) int {
+	rank := 0
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && x.next[i].key < key {
+			rank += x.span[i]
+			x = x.next[i]
+		}
+	}
+	return rank
+}
+
+// At returns the key at the given index in sorted order, with its value.
+func (s *SkipList[K, V]) At(index int) (K, V, bool) {
+	if index < 0 || index >= s.length {
+		return entry[K, V](nil)
+	}
+	target := index + 1 // the head has rank 0
+	traversed := 0
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && traversed+x.span[i] <= target {
+			traversed += x.span[i]
+			x = x.next[i]
+		}
+		if traversed == target {
+			break
+		}
+	}
+	return entry(x)
+}
+
+// Range returns an iterator over the keys k with from <= k < to in ascending order.
+// The list must not be modified while iterating.
+func (s *SkipList[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for x := s.ceilingNode(from); x != nil && x.key < to; x = x.next[0] {
+			if !yield(x.key, x.value) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over all keys of the list in ascending order.
+func (s *SkipList[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for x := s.head.next[0]; x != nil; x = x.next[0] {
+			if !yield(x.key, x.value) {
+				return
+			}
+		}
+	}
+}
+
+func RunSkipList() {
+	s := NewSkipListWithSeed[int, string](42)
+	for _, v := range []int{30, 10, 50, 20, 40, 70, 60} {
+		s.Put(v, fmt.Sprintf("v%d", v))
+	}
+	fmt.Println(s.Get(40))
+	fmt.Println(s.Put(40, "forty"))
+	fmt.Println(s.Floor(45))
+	fmt.Println(s.Ceiling(45))
+	fmt.Println("Rank of 50:", s.Rank(50))
+	fmt.Println(s.At(2))
+
+	for k, v := range s.Range(20, 60) {
+		fmt.Printf("%d: %s\n", k, v)
+	}
+
+	fmt.Println("Deleted:", s.Delete(30), s.Delete(35))
+	fmt.Println("Len:", s.Len(), "Rank of 50:", s.Rank(50))
+}
@@ -0,0 +1,205 @@
+package skiplist
+
+import (
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"testing"
+)
+
+const (
+	operations = 3000
+	keyRange   = 400
+)
+
+// oracle is a sorted slice of keys with their values, the reference the skip list is
+// compared with.
+type oracle struct {
+	keys   []int
+	values map[int]int
+}
+
+func (o *oracle) put(key, value int) {
+	if _, ok := o.values[key]; !ok {
+		i, _ := slices.BinarySearch(o.keys, key)
+		o.keys = slices.Insert(o.keys, i, key)
+	}
+	o.values[key] = value
+}
+
+func (o *oracle) delete(key int) {
+	if i, ok := slices.BinarySearch(o.keys, key); ok {
+		o.keys = slices.Delete(o.keys, i, i+1)
+		delete(o.values, key)
+	}
+}
+
+func (o *oracle) floor(key int) (int, bool) {
+	i, ok := slices.BinarySearch(o.keys, key)
+	if ok {
+		return key, true
+	}
+	if i == 0 {
+		return 0, false
+	}
+	return o.keys[i-1], true
+}
+
+func (o *oracle) ceiling(key int) (int, bool) {
+	i, _ := slices.BinarySearch(o.keys, key)
+	if i == len(o.keys) {
+		return 0, false
+	}
+	return o.keys[i], true
+}
+
+func (o *oracle) rank(key int) int {
+	i, _ := slices.BinarySearch(o.keys, key)
+	return i
+}
+
+func TestSkipListRandom(t *testing.T) {
+	s := NewSkipListWithSeed[int, int](42)
+	o := &oracle{values: make(map[int]int)}
+	r := rand.New(rand.NewPCG(7, 7))
+
+	for step := range operations {
+		key := r.IntN(keyRange)
+		if r.IntN(3) > 0 {
+			previous, replaced := s.Put(key, step)
+			want, present := o.values[key]
+			if replaced != present || previous != want {
+				t.Fatalf("step %d: Put(%d) = %d, %t; want %d, %t", step, key, previous, replaced, want, present)
+			}
+			o.put(key, step)
+		} else {
+			_, present := o.values[key]
+			if deleted := s.Delete(key); deleted != present {
+				t.Fatalf("step %d: Delete(%d) = %t, want %t", step, key, deleted, present)
+			}
+			o.delete(key)
+		}
+		if s.Len() != len(o.keys) {
+			t.Fatalf("step %d: Len() = %d, want %d", step, s.Len(), len(o.keys))
+		}
+
+		probe := r.IntN(keyRange+2) - 1
+		k, v, ok := s.Floor(probe)
+		if want, wantOK := o.floor(probe); ok != wantOK || ok && (k != want || v != o.values[want]) {
+			t.Fatalf("step %d: Floor(%d) = %d, %d, %t; want %d, %t", step, probe, k, v, ok, want, wantOK)
+		}
+		k, v, ok = s.Ceiling(probe)
+		if want, wantOK := o.ceiling(probe); ok != wantOK || ok && (k != want || v != o.values[want]) {
+			t.Fatalf("step %d: Ceiling(%d) = %d, %d, %t; want %d, %t", step, probe, k, v, ok, want, wantOK)
+		}
+		if got, want := s.Rank(probe), o.rank(probe); got != want {
+			t.Fatalf("step %d: Rank(%d) = %d, want %d", step, probe, got, want)
+		}
+		index := r.IntN(len(o.keys)+2) - 1
+		k, v, ok = s.At(index)
+		if wantOK := index >= 0 && index < len(o.keys); ok != wantOK || ok && (k != o.keys[index] || v != o.values[k]) {
+			t.Fatalf("step %d: At(%d) = %d, %d, %t", step, index, k, v, ok)
+		}
+
+		from := r.IntN(keyRange)
+		to := from + r.IntN(keyRange/4)
+		var got []int
+		for k, v := range s.Range(from, to) {
+			if v != o.values[k] {
+				t.Fatalf("step %d: Range yielded %d: %d, want %d", step, k, v, o.values[k])
+			}
+			got = append(got, k)
+		}
+		if want := o.keys[o.rank(from):o.rank(to)]; !slices.Equal(got, want) {
+			t.Fatalf("step %d: Range(%d, %d) = %v, want %v", step, from, to, got, want)
+		}
+	}
+
+	var all []int
+	for k := range s.All() {
+		all = append(all, k)
+	}
+	if !slices.Equal(all, o.keys) {
+		t.Fatalf("All() = %v, want %v", all, o.keys)
+	}
+}
+
+func TestSkipListSeedReproducible(t *testing.T) {
+	a := NewSkipListWithSeed[int, int](1)
+	b := NewSkipListWithSeed[int, int](1)
+	for i := range 1000 {
+		a.Put(i, i)
+		b.Put(i, i)
+	}
+	if a.level != b.level {
+		t.Errorf("levels %d and %d for the same seed", a.level, b.level)
+	}
+	for x, y := a.head, b.head; x != nil; x, y = x.next[0], y.next[0] {
+		if len(x.next) != len(y.next) {
+			t.Fatalf("key %d has %d levels and %d for the same seed", x.key, len(x.next), len(y.next))
+		}
+	}
+}
+
+func TestConcurrentSkipList(t *testing.T) {
+	const workers, keys = 8, 500
+	c := NewConcurrentSkipListWithSeed[int, int](3)
+
+	// each worker owns the keys congruent to its number, so the final state is known
+	var wg sync.WaitGroup
+	for worker := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := worker; i < keys; i += workers {
+				c.Put(i, i)
+				if v, ok := c.Get(i); !ok || v != i {
+					t.Errorf("Get(%d) = %d, %t right after Put", i, v, ok)
+				}
+				if i%2 == 1 {
+					c.Delete(i)
+				}
+			}
+		}()
+	}
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range keys {
+				c.Floor(i)
+				c.Ceiling(i)
+				c.Rank(i)
+				c.At(i % (c.Len() + 1))
+				previous := -1
+				for k := range c.Range(i, i+20) {
+					if k <= previous {
+						t.Errorf("Range yielded %d after %d", k, previous)
+					}
+					previous = k
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if c.Len() != keys/2 {
+		t.Errorf("Len() = %d, want %d", c.Len(), keys/2)
+	}
+	for i := range keys {
+		if _, ok := c.Get(i); ok != (i%2 == 0) {
+			t.Errorf("Get(%d) found = %t, want %t", i, ok, i%2 == 0)
+		}
+		if rank := c.Rank(i); rank != (i+1)/2 {
+			t.Errorf("Rank(%d) = %d, want %d", i, rank, (i+1)/2)
+		}
+	}
+
+	// the loop body may write to the list, the entries were copied under the lock
+	for k, v := range c.Range(0, keys) {
+		c.Put(k, v+1)
+	}
+	if v, _ := c.Get(0); v != 1 {
+		t.Errorf("Get(0) = %d after updating from Range, want 1", v)
+	}
+}
@@ -0,0 +1,31 @@
+package sort
+
+import (
+	"cmp"
+	"fmt"
+
+	"github.com/dev-crusader/data-structures-and-algorithms/datastructure/heap"
+)
+
+// HeapSort sorts s in place in the ascending order given by cmp, in O(n log n) time.
+// The slice is turned into a max-heap, whose top is then repeatedly swapped behind the
+// shrinking heap by heap.Heap.HeapSort. The sort is not stable.
+func HeapSort[T any](s []T, cmp func(a, b T) int) {
+	heap.FromSlice(s, func(a, b T) int {
+		return cmp(b, a)
+	}).HeapSort()
+}
+
+func SortWithHeap() {
+	inputArr := generateRandomArray(-100, 100, 15)
+	fmt.Printf("Before:\t%v\n", inputArr)
+	HeapSort(inputArr, cmp.Compare[int])
+	fmt.Printf("After:\t%v\n", inputArr)
+
+	words := []string{"pear", "fig", "apple", "kiwi"}
+	// longest first
+	HeapSort(words, func(a, b string) int {
+		return cmp.Compare(len(b), len(a))
+	})
+	fmt.Println(words)
+}
@@ -0,0 +1,49 @@
+package sort
+
+import (
+	"cmp"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestHeapSort(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	inputs := map[string][]int{
+		"empty":  {},
+		"nil":    nil,
+		"single": {7},
+		"equal":  {3, 3, 3, 3, 3},
+		"sorted": {1, 2, 3, 4, 5},
+		"desc":   {5, 4, 3, 2, 1},
+	}
+	for _, n := range []int{2, 3, 10, 100, 1000} {
+		values := make([]int, n)
+		for i := range values {
+			values[i] = r.IntN(n) - n/2
+		}
+		inputs[fmt.Sprintf("random %d", n)] = values
+	}
+
+	for name, input := range inputs {
+		t.Run(name, func(t *testing.T) {
+			got := slices.Clone(input)
+			want := slices.Clone(input)
+			HeapSort(got, cmp.Compare[int])
+			slices.SortFunc(want, cmp.Compare[int])
+			if !slices.Equal(got, want) {
+				t.Errorf("HeapSort(%v) = %v, want %v", input, got, want)
+			}
+		})
+	}
+}
+
+func TestHeapSortCustomOrder(t *testing.T) {
+	words := []string{"pear", "fig", "apple", "kiwi", "banana"}
+	byLengthDesc := func(a, b string) int { return cmp.Compare(len(b), len(a)) }
+	HeapSort(words, byLengthDesc)
+	if !slices.IsSortedFunc(words, byLengthDesc) {
+		t.Errorf("HeapSort by length descending = %v", words)
+	}
+}
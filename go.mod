@@ -1,5 +1,5 @@
 module github.com/dev-crusader/data-structures-and-algorithms
 
-go 1.22.7
+go 1.24
 
 require golang.org/x/exp v0.0.0-20240909161429-701f63a606c0
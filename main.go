@@ -22,12 +22,18 @@ func main() {
 	// ds.CallHeap()
 	// srt.QuickSortApproach()
 	// srt.Radix()
+	// srt.SortWithHeap()
 	// tp.DuplicateRemove()
 	// ds.CallHeap()
 	// bst.BST()
+	// bst.RunBalancedTrees()
 	// gh.InitGraph()
 	// lc.Run()
 	// gen.GenericMap()
+	// gen.ConcurrentMap()
+	// gen.OpenMap()
+	// gen.SerializeMap()
+	// ch.RunCache()
 	// hm.InitHashMap()
 	// cp.FanInFanOut()
 	cp.Pipeline()